@@ -2,6 +2,7 @@ package main
 
 import (
 	"flag"
+	"strconv"
 
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/config"
 	catalog_constants "github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/constants"
@@ -15,6 +16,13 @@ func loadConfig(catalogDatabaseFilename, sloDatabaseFilename string) config.Conf
 	port := flag.String("port", "8080", "Port for SSE server")
 	baseURL := flag.String("baseurl", "http://localhost", "Base URL for SSE server")
 	catalogDatabaseFile := flag.String("catalog-databasefile", catalogDatabaseFilename, "Full path to the catalog SQLite database file")
+	baselineCatalogDatabaseFile := flag.String("baseline-catalog-databasefile", "", "Full path to an older catalog SQLite database file to compare the catalog with")
+	specRootDir := flag.String("spec-rootdir", "", "Full path to the source checkout the OpenAPI and RPL specifications of the catalog are read from")
+	baselineSpecRootDir := flag.String("baseline-spec-rootdir", "", "Full path to the source checkout the specifications of the older catalog are read from (required to compare specifications)")
+	complexityConfigFile := flag.String("complexity-config", "", "Full path to a YAML file with complexity scoring profiles (default built-in profiles)")
+	enableQueryCatalog := flag.Bool("enable-query-catalog", false, "Offer a tool to run read-only SQL queries on the catalog database")
+	semanticModelFile := flag.String("semantic-model-file", "", "Full path to a SQLite file the trained semantic model is kept in between runs; created when missing (default: retrain on every start)")
+	searchWeights := flag.String("search-weights", "", "Weights of the categories when ranking suggested candidates, like module=1.5,kind=0.5 (default 1 for all)")
 	sloDatabaseFile := flag.String("slo-databasefile", sloDatabaseFilename, "Full path to the SLO SQLite database file")
 	apiKey := flag.String("api-key", "", "API key for authentication (default empty)")
 	mode := flag.String("mode", "both", "slo, service-catalog or both")
@@ -28,8 +36,15 @@ func loadConfig(catalogDatabaseFilename, sloDatabaseFilename string) config.Conf
 		APIKey:        *apiKey,
 		Mode:          config.Mode(*mode),
 		PluginConfigs: map[string]string{
-			catalog_constants.CatalogDatabaseFilenameKey: *catalogDatabaseFile,
-			slo_constants.SLODatabaseFilenameKey:         *sloDatabaseFile,
+			catalog_constants.CatalogDatabaseFilenameKey:         *catalogDatabaseFile,
+			catalog_constants.BaselineCatalogDatabaseFilenameKey: *baselineCatalogDatabaseFile,
+			catalog_constants.SpecRootDirKey:                     *specRootDir,
+			catalog_constants.ComplexityConfigFilenameKey:        *complexityConfigFile,
+			catalog_constants.BaselineSpecRootDirKey:             *baselineSpecRootDir,
+			catalog_constants.EnableQueryCatalogKey:              strconv.FormatBool(*enableQueryCatalog),
+			catalog_constants.SemanticModelFilenameKey:           *semanticModelFile,
+			catalog_constants.SearchWeightsKey:                   *searchWeights,
+			slo_constants.SLODatabaseFilenameKey:                 *sloDatabaseFile,
 		},
 	}
 }
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/diff"
+	catalog_repo "github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+// runDiff implements the "diff" subcommand that prints the changes between two catalog database files.
+func runDiff(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	asJSON := flags.Bool("json", false, "Print the differences as JSON instead of a markdown changelog")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s diff [-json] <old-catalog-databasefile> <new-catalog-databasefile>\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return fmt.Errorf("expected two catalog database files, got %d", flags.NArg())
+	}
+
+	oldRepo := catalog_repo.New(flags.Arg(0))
+	err = oldRepo.Open(ctx)
+	if err != nil {
+		return fmt.Errorf("error opening old catalog-database: %w", err)
+	}
+	defer oldRepo.Close(ctx)
+
+	newRepo := catalog_repo.New(flags.Arg(1))
+	err = newRepo.Open(ctx)
+	if err != nil {
+		return fmt.Errorf("error opening new catalog-database: %w", err)
+	}
+	defer newRepo.Close(ctx)
+
+	differences, err := diff.Compare(ctx, oldRepo, newRepo)
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(differences)
+	}
+	fmt.Print(differences.Changelog())
+	return nil
+}
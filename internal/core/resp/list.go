@@ -9,7 +9,16 @@ func SliceToList(names []string) List {
 	return list
 }
 
+// SliceToPagedList wraps a page of a string-slice into a single object, together with the cursor of the next page
+func SliceToPagedList(names []string, page Page) List {
+	entries, nextCursor := Paginate(names, page)
+	list := SliceToList(entries)
+	list.NextCursor = nextCursor
+	return list
+}
+
 // List wraps a string-slice into a single object
 type List struct {
-	Names []string `json:"names"`
+	Names      []string `json:"names"`
+	NextCursor string   `json:"next_cursor,omitempty"`
 }
@@ -0,0 +1,115 @@
+package resp
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// DefaultPageSize is the number of entries returned by a list when no page_size is given
+	DefaultPageSize = 100
+	// MaxPageSize is the largest page_size a client can ask for
+	MaxPageSize = 1000
+
+	cursorPrefix = "offset:"
+)
+
+// Page is a window on a list: the entries from Offset up to Offset+Size
+type Page struct {
+	Offset int
+	Size   int
+}
+
+// WithPagination adds the page_size and cursor parameters to a list tool
+func WithPagination() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithNumber("page_size", mcp.Description(fmt.Sprintf("Maximum number of entries per page (default %d, max %d).", DefaultPageSize, MaxPageSize)))(tool)
+		mcp.WithString("cursor", mcp.Description("The next_cursor of the previous response to get the next page. Omit for the first page."))(tool)
+	}
+}
+
+// PageFromRequest extracts the page_size and cursor parameters of a list tool.
+// When they are invalid, the returned tool result describes the problem.
+func PageFromRequest(ctx context.Context, request mcp.CallToolRequest) (Page, *mcp.CallToolResult) {
+	pageSize := request.GetInt("page_size", DefaultPageSize)
+	if pageSize < 1 || pageSize > MaxPageSize {
+		return Page{}, mcp.NewToolResultError(
+			InvalidInput(ctx, fmt.Sprintf("Invalid page_size %d", pageSize),
+				"page_size",
+				fmt.Sprintf("Use a page_size between 1 and %d", MaxPageSize)))
+	}
+	cursor := request.GetString("cursor", "")
+	page, err := NewPage(pageSize, cursor)
+	if err != nil {
+		return Page{}, mcp.NewToolResultError(
+			InvalidInput(ctx, fmt.Sprintf("Invalid cursor %s", cursor),
+				"cursor",
+				"Use the next_cursor of the previous response or omit it for the first page"))
+	}
+	return page, nil
+}
+
+// PageFromArguments extracts the page_size and cursor arguments of a resource template
+func PageFromArguments(arguments map[string]any) (Page, error) {
+	pageSize := DefaultPageSize
+	if value := argument(arguments, "page_size"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 1 || size > MaxPageSize {
+			return Page{}, fmt.Errorf("invalid page_size %s: use a value between 1 and %d", value, MaxPageSize)
+		}
+		pageSize = size
+	}
+	return NewPage(pageSize, argument(arguments, "cursor"))
+}
+
+func argument(arguments map[string]any, name string) string {
+	switch value := arguments[name].(type) {
+	case string:
+		return value
+	case []string:
+		if len(value) > 0 {
+			return value[0]
+		}
+	}
+	return ""
+}
+
+// NewPage creates the page of the given size that starts at the cursor. An empty cursor starts at the beginning.
+func NewPage(pageSize int, cursor string) (Page, error) {
+	if cursor == "" {
+		return Page{Offset: 0, Size: pageSize}, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(decoded), cursorPrefix) {
+		return Page{}, fmt.Errorf("invalid cursor %s", cursor)
+	}
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(decoded), cursorPrefix))
+	if err != nil || offset < 0 {
+		return Page{}, fmt.Errorf("invalid cursor %s", cursor)
+	}
+	return Page{Offset: offset, Size: pageSize}, nil
+}
+
+// Paginate returns the entries of the page and the cursor of the next page. The cursor is empty on the last page.
+func Paginate[T any](entries []T, page Page) ([]T, string) {
+	start := min(page.Offset, len(entries))
+	end := min(start+page.Size, len(entries))
+	if end >= len(entries) {
+		return entries[start:end], ""
+	}
+	return entries[start:end], encodeCursor(end)
+}
+
+// NextCursor returns the cursor of the page after this one
+func (p Page) NextCursor() string {
+	return encodeCursor(p.Offset + p.Size)
+}
+
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s%d", cursorPrefix, offset)))
+}
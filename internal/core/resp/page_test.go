@@ -0,0 +1,86 @@
+package resp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginate(t *testing.T) {
+	entries := []string{"a", "b", "c", "d", "e"}
+
+	page, cursor := Paginate(entries, Page{Size: 2})
+	assert.Equal(t, []string{"a", "b"}, page)
+	assert.NotEmpty(t, cursor)
+
+	next, err := NewPage(2, cursor)
+	assert.NoError(t, err)
+	page, cursor = Paginate(entries, next)
+	assert.Equal(t, []string{"c", "d"}, page)
+
+	next, err = NewPage(2, cursor)
+	assert.NoError(t, err)
+	page, cursor = Paginate(entries, next)
+	assert.Equal(t, []string{"e"}, page)
+	assert.Empty(t, cursor)
+}
+
+func TestPaginateExactFitAndBeyond(t *testing.T) {
+	page, cursor := Paginate([]int{1, 2}, Page{Size: 2})
+	assert.Equal(t, []int{1, 2}, page)
+	assert.Empty(t, cursor)
+
+	page, cursor = Paginate([]int{1, 2}, Page{Offset: 5, Size: 2})
+	assert.Empty(t, page)
+	assert.Empty(t, cursor)
+}
+
+func TestNewPageInvalidCursor(t *testing.T) {
+	for _, cursor := range []string{"not base64!", "eHl6", encodeCursor(-1)} {
+		_, err := NewPage(10, cursor)
+		assert.ErrorContains(t, err, "invalid cursor", cursor)
+	}
+}
+
+func TestPageFromRequest(t *testing.T) {
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"page_size": 10, "cursor": encodeCursor(20)}
+
+	page, result := PageFromRequest(context.Background(), request)
+	assert.Nil(t, result)
+	assert.Equal(t, Page{Offset: 20, Size: 10}, page)
+
+	page, result = PageFromRequest(context.Background(), mcp.CallToolRequest{})
+	assert.Nil(t, result)
+	assert.Equal(t, Page{Offset: 0, Size: DefaultPageSize}, page)
+}
+
+func TestPageFromRequestInvalid(t *testing.T) {
+	for _, arguments := range []map[string]interface{}{
+		{"page_size": 0},
+		{"page_size": MaxPageSize + 1},
+		{"cursor": "garbage"},
+	} {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+
+		_, result := PageFromRequest(context.Background(), request)
+		assert.NotNil(t, result)
+		assert.True(t, result.IsError)
+	}
+}
+
+func TestPageFromArguments(t *testing.T) {
+	page, err := PageFromArguments(map[string]any{"page_size": "5", "cursor": encodeCursor(10)})
+	assert.NoError(t, err)
+	assert.Equal(t, Page{Offset: 10, Size: 5}, page)
+
+	page, err = PageFromArguments(map[string]any{})
+	assert.NoError(t, err)
+	assert.Equal(t, Page{Offset: 0, Size: DefaultPageSize}, page)
+
+	_, err = PageFromArguments(map[string]any{"page_size": []string{"many"}})
+	assert.ErrorContains(t, err, "invalid page_size many")
+}
@@ -0,0 +1,79 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+const defaultImpactDepth = 3
+
+// NewAnalyzeImpactTool returns the MCP tool definition and its handler for analysing the blast-radius of a change.
+func (h *mcpHandler) analyzeImpactTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"analyze_impact",
+			mcp.WithDescription("Analyses the blast-radius of changing an interface (=web-api) or a database. "+
+				"Returns all directly and transitively affected modules grouped by owning team, critical flow and application kind. "+
+				"Provide either interface_id or database_id."),
+			mcp.WithString("interface_id", mcp.Description("The ID of the interface (=web-api) that changes")),
+			mcp.WithString("database_id", mcp.Description("The ID of the database that changes")),
+			mcp.WithNumber("depth", mcp.Description(fmt.Sprintf("Maximum number of hops from the changed entity (default %d, max %d). Direct users are at distance 1.", defaultImpactDepth, maxDependencyGraphDepth))),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[repo.ImpactAnalysis](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			interfaceID := request.GetString("interface_id", "")
+			databaseID := request.GetString("database_id", "")
+			if (interfaceID == "") == (databaseID == "") {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Provide either interface_id or database_id",
+						"interface_id",
+						"Use a valid interface identifier or a valid database identifier, but not both")), nil
+			}
+			depth := min(max(request.GetInt("depth", defaultImpactDepth), 1), maxDependencyGraphDepth)
+
+			subject, id, fieldName := repo.ImpactSubjectInterface, interfaceID, "interface_id"
+			if databaseID != "" {
+				subject, id, fieldName = repo.ImpactSubjectDatabase, databaseID, "database_id"
+			}
+
+			// call business logic
+			analysis, exists, err := h.repo.GetImpactAnalysis(ctx, subject, id, depth)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error analysing impact of %s %s: %s", subject, id, err))), nil
+			}
+			if !exists {
+				candidates := h.idx.Search(ctx, id, 10)
+				suggestions := candidates.Interfaces
+				if subject == repo.ImpactSubjectDatabase {
+					suggestions = candidates.Databases
+				}
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("%s with ID %s not found", subjectLabel(subject), id),
+						fieldName,
+						suggestions,
+					)), nil
+			}
+
+			return mcp.NewToolResultJSON[repo.ImpactAnalysis](analysis)
+		},
+	}
+}
+
+func subjectLabel(subject repo.ImpactSubject) string {
+	if subject == repo.ImpactSubjectDatabase {
+		return "Database"
+	}
+	return "Interface"
+}
@@ -0,0 +1,151 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestAnalyzeImpactTool_SuccessInterface(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetImpactAnalysis(gomock.Any(), repo.ImpactSubjectInterface, "interface1", defaultImpactDepth).Return(repo.ImpactAnalysis{
+		Subject:   repo.ImpactSubjectInterface,
+		SubjectID: "interface1",
+		AffectedModules: []repo.AffectedModule{
+			{ModuleID: "module1", Distance: 1, Team: "team1", Flows: []string{"flow1"}},
+			{ModuleID: "module2", Distance: 2, Team: "team2"},
+		},
+		ByTeam: map[string][]string{"team1": {"module1"}, "team2": {"module2"}},
+		ByFlow: map[string][]string{"flow1": {"module1"}},
+		ByKind: map[string][]string{},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).analyzeImpactTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("analyze_impact", map[string]interface{}{
+		"interface_id": "interface1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"byTeam":{"team1":["module1"],"team2":["module2"]}`)
+	assert.Contains(t, textResult.Text, `"byFlow":{"flow1":["module1"]}`)
+}
+
+func TestAnalyzeImpactTool_SuccessDatabase(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetImpactAnalysis(gomock.Any(), repo.ImpactSubjectDatabase, "database1", 1).Return(repo.ImpactAnalysis{
+		Subject:         repo.ImpactSubjectDatabase,
+		SubjectID:       "database1",
+		AffectedModules: []repo.AffectedModule{{ModuleID: "module1", Distance: 1}},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).analyzeImpactTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("analyze_impact", map[string]interface{}{
+		"database_id": "database1",
+		"depth":       1,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"subject":"database","subjectID":"database1"`)
+}
+
+func TestAnalyzeImpactTool_NotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetImpactAnalysis(gomock.Any(), repo.ImpactSubjectDatabase, "nonexistent_database", defaultImpactDepth).Return(repo.ImpactAnalysis{}, false, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_database", 10).Return(search.Result{Databases: []string{"suggested_database"}})
+
+	tool := NewMCPHandler(repository, idx).analyzeImpactTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("analyze_impact", map[string]interface{}{
+		"database_id": "nonexistent_database",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Database with ID nonexistent_database not found")
+	assert.Contains(t, textResult.Text, "suggested_database")
+}
+
+func TestAnalyzeImpactTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetImpactAnalysis(gomock.Any(), repo.ImpactSubjectInterface, "interface_with_error", defaultImpactDepth).Return(repo.ImpactAnalysis{}, false, errors.New("failed to analyse"))
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).analyzeImpactTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("analyze_impact", map[string]interface{}{
+		"interface_id": "interface_with_error",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error analysing impact of interface interface_with_error: failed to analyse")
+}
+
+func TestAnalyzeImpactTool_InvalidInput(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).analyzeImpactTool()
+
+	for _, args := range []map[string]interface{}{
+		nil,
+		{"interface_id": "interface1", "database_id": "database1"},
+	} {
+		// When
+		result, err := tool.Handler(context.Background(), createRequest("analyze_impact", args))
+
+		// Then
+		assert.NoError(t, err)
+		expectError(t, result, `"status": "invalid_input"`)
+		textResult := result.Content[0].(mcp.TextContent)
+		assert.Contains(t, textResult.Text, "Provide either interface_id or database_id")
+	}
+}
@@ -0,0 +1,107 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
+)
+
+// NewCompareOpenAPISpecificationTool returns the MCP tool definition and its handler for detecting breaking changes in the OpenAPI specification of an interface.
+func (h *mcpHandler) compareOpenAPISpecificationTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"compare_openapi_specification",
+			mcp.WithDescription("Compares the OpenAPI specification of an interface (=web-api) in the older generation of the catalog with the current one. "+
+				"Classifies every difference (removed operation, new required field or parameter, changed type, narrowed enum, ...) as breaking or non-breaking "+
+				"and lists the consuming modules affected by each breaking change."),
+			mcp.WithString("interface_id", mcp.Required(), mcp.Description("The ID of the interface to compare the OpenAPI specification of")),
+			mcp.WithBoolean("breaking_only", mcp.Description("Only return the breaking changes")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[OpenAPICompatibility](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			interfaceID, err := request.RequireString("interface_id")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing interface_id",
+						"interface_id",
+						"Use a valid interface identifier")), nil
+			}
+			breakingOnly := request.GetBool("breaking_only", false)
+
+			// call business logic
+			newInterface, exists, err := h.repo.GetInterfaceOnID(ctx, interfaceID)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error getting interface %s: %s", interfaceID, err))), nil
+			}
+			if !exists {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Interface with ID %s not found", interfaceID),
+						"interface_id",
+						h.idx.Search(ctx, interfaceID, 10).Interfaces,
+					)), nil
+			}
+
+			oldInterface, exists, err := h.baseline.GetInterfaceOnID(ctx, interfaceID)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error getting interface %s from baseline catalog: %s", interfaceID, err))), nil
+			}
+			if !exists {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Interface with ID %s not found in baseline catalog", interfaceID),
+						"interface_id",
+						[]string{},
+					)), nil
+			}
+
+			_, oldSpec, errorResult := loadOpenAPISpecification(ctx, h.baselineSpecs, oldInterface, "baseline")
+			if errorResult != nil {
+				return errorResult, nil
+			}
+			_, newSpec, errorResult := loadOpenAPISpecification(ctx, h.specs, newInterface, "")
+			if errorResult != nil {
+				return errorResult, nil
+			}
+
+			consumers, _, err := h.repo.ListInterfaceConsumers(ctx, interfaceID)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error listing consumers of interface %s: %s", interfaceID, err))), nil
+			}
+
+			compatibility := OpenAPICompatibility{
+				InterfaceID:          interfaceID,
+				OldSpecificationPath: *oldInterface.OpenAPISpecs,
+				NewSpecificationPath: *newInterface.OpenAPISpecs,
+				Changes:              []OpenAPIChange{},
+			}
+			for _, change := range spec.CompareOpenAPI(oldSpec, newSpec) {
+				if !change.Breaking {
+					if !breakingOnly {
+						compatibility.Changes = append(compatibility.Changes, OpenAPIChange{Change: change})
+					}
+					continue
+				}
+				// Consumption is registered per interface, so every consumer may use the changed operation
+				compatibility.BreakingCount++
+				compatibility.Changes = append(compatibility.Changes, OpenAPIChange{Change: change, AffectedConsumers: consumers})
+			}
+
+			return mcp.NewToolResultJSON[OpenAPICompatibility](compatibility)
+		},
+	}
+}
@@ -0,0 +1,221 @@
+package servicecatalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
+)
+
+const newOpenAPISpecification = `{
+	"openapi": "3.0.1",
+	"paths": {
+		"/companies": {
+			"get": {
+				"operationId": "listCompanies",
+				"deprecated": true,
+				"responses": {"200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/ListCompanyResponse"}}}}}
+			}
+		},
+		"/merchants": {
+			"get": {"operationId": "listMerchants"}
+		}
+	}
+}`
+
+func TestCompareOpenAPISpecificationTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID:  "interface1",
+		OpenAPISpecs: stringPointer("specs/interface1-v2.json"),
+	}, true, nil)
+	repository.EXPECT().ListInterfaceConsumers(gomock.Any(), "interface1").Return([]string{"module2", "module3"}, true, nil)
+
+	baseline := repo.NewMockCataloger(ctrl)
+	baseline.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID:  "interface1",
+		OpenAPISpecs: stringPointer("specs/interface1-v1.json"),
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	loader := spec.NewMockLoader(ctrl)
+	loader.EXPECT().Load(gomock.Any(), "specs/interface1-v2.json").Return([]byte(newOpenAPISpecification), true, nil)
+
+	baselineLoader := spec.NewMockLoader(ctrl)
+	baselineLoader.EXPECT().Load(gomock.Any(), "specs/interface1-v1.json").Return([]byte(openAPISpecification), true, nil)
+
+	tool := NewMCPHandler(repository, idx, WithSpecLoader(loader), WithBaselineCatalog(baseline), WithBaselineSpecLoader(baselineLoader)).compareOpenAPISpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("compare_openapi_specification", map[string]interface{}{
+		"interface_id": "interface1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"oldSpecificationPath":"specs/interface1-v1.json","newSpecificationPath":"specs/interface1-v2.json","breakingCount":1`)
+	assert.Contains(t, textResult.Text, `{"operation":"POST /companies","kind":"removed-operation","breaking":true,"description":"operation was removed","affectedConsumers":["module2","module3"]}`)
+	assert.Contains(t, textResult.Text, `{"operation":"GET /merchants","kind":"added-operation","breaking":false,"description":"operation was added"}`)
+}
+
+func TestCompareOpenAPISpecificationTool_BreakingOnly(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID:  "interface1",
+		OpenAPISpecs: stringPointer("specs/interface1.json"),
+	}, true, nil)
+	repository.EXPECT().ListInterfaceConsumers(gomock.Any(), "interface1").Return([]string{"module2"}, true, nil)
+
+	baseline := repo.NewMockCataloger(ctrl)
+	baseline.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID:  "interface1",
+		OpenAPISpecs: stringPointer("specs/interface1.json"),
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	loader := spec.NewMockLoader(ctrl)
+	loader.EXPECT().Load(gomock.Any(), "specs/interface1.json").Return([]byte(newOpenAPISpecification), true, nil)
+
+	baselineLoader := spec.NewMockLoader(ctrl)
+	baselineLoader.EXPECT().Load(gomock.Any(), "specs/interface1.json").Return([]byte(openAPISpecification), true, nil)
+
+	tool := NewMCPHandler(repository, idx, WithSpecLoader(loader), WithBaselineCatalog(baseline), WithBaselineSpecLoader(baselineLoader)).compareOpenAPISpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("compare_openapi_specification", map[string]interface{}{
+		"interface_id":  "interface1",
+		"breaking_only": true,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"removed-operation"`)
+	assert.NotContains(t, textResult.Text, `"added-operation"`)
+}
+
+func TestCompareOpenAPISpecificationTool_NotInBaseline(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID:  "interface1",
+		OpenAPISpecs: stringPointer("specs/interface1.json"),
+	}, true, nil)
+
+	baseline := repo.NewMockCataloger(ctrl)
+	baseline.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{}, false, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx, WithBaselineCatalog(baseline)).compareOpenAPISpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("compare_openapi_specification", map[string]interface{}{
+		"interface_id": "interface1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Interface with ID interface1 not found in baseline catalog")
+}
+
+func TestCompareOpenAPISpecificationTool_NoBaselineSpecification(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID:  "interface1",
+		OpenAPISpecs: stringPointer("specs/interface1.json"),
+	}, true, nil)
+
+	baseline := repo.NewMockCataloger(ctrl)
+	baseline.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{InterfaceID: "interface1"}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx, WithBaselineCatalog(baseline)).compareOpenAPISpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("compare_openapi_specification", map[string]interface{}{
+		"interface_id": "interface1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Interface with ID interface1 has no OpenAPI specification in baseline catalog")
+}
+
+func TestCompareOpenAPISpecificationTool_NotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "nonexistent_interface").Return(repo.Interface{}, false, nil)
+
+	baseline := repo.NewMockCataloger(ctrl)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_interface", 10).Return(search.Result{Interfaces: []string{"suggested_interface"}})
+
+	tool := NewMCPHandler(repository, idx, WithBaselineCatalog(baseline)).compareOpenAPISpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("compare_openapi_specification", map[string]interface{}{
+		"interface_id": "nonexistent_interface",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Interface with ID nonexistent_interface not found")
+	assert.Contains(t, textResult.Text, "suggested_interface")
+}
+
+func TestCompareOpenAPISpecificationTool_MissingInterfaceID(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	baseline := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx, WithBaselineCatalog(baseline)).compareOpenAPISpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("compare_openapi_specification", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Missing interface_id")
+}
@@ -0,0 +1,92 @@
+package complexity
+
+import (
+	"sort"
+)
+
+// Breakdown explains a complexity score: what each metric contributes and how the module compares to all modules and to its team.
+type Breakdown struct {
+	Profile         string            `json:"profile"`
+	Score           float64           `json:"score"`
+	Percentile      float64           `json:"percentile"`
+	TeamMedianScore float64           `json:"teamMedianScore"`
+	Metrics         []MetricBreakdown `json:"metrics"`
+}
+
+// MetricBreakdown is the contribution of a single metric, with the percentile of its value across all modules
+// and the median value of that metric within the team.
+type MetricBreakdown struct {
+	Contribution
+	Percentile float64 `json:"percentile"`
+	TeamMedian float64 `json:"teamMedian"`
+}
+
+// Explain breaks down the complexity score of a module with the given values.
+// The population holds the values of all modules, team the values of the modules of the same team.
+func Explain(profile Profile, values Values, population []Values, team []Values) Breakdown {
+	maxima := Maxima(population)
+
+	scores := []float64{}
+	for _, other := range population {
+		scores = append(scores, profile.Score(other, maxima))
+	}
+	teamScores := []float64{}
+	for _, other := range team {
+		teamScores = append(teamScores, profile.Score(other, maxima))
+	}
+
+	score := profile.Score(values, maxima)
+	breakdown := Breakdown{
+		Profile:         profile.Name,
+		Score:           score,
+		Percentile:      Percentile(scores, score),
+		TeamMedianScore: Median(teamScores),
+		Metrics:         []MetricBreakdown{},
+	}
+	for _, contribution := range profile.Contributions(values, maxima) {
+		breakdown.Metrics = append(breakdown.Metrics, MetricBreakdown{
+			Contribution: contribution,
+			Percentile:   Percentile(metricValues(population, contribution.Metric), contribution.Value),
+			TeamMedian:   Median(metricValues(team, contribution.Metric)),
+		})
+	}
+	return breakdown
+}
+
+// Percentile returns the percentage (0..100) of values below the given value, counting equal values half.
+func Percentile(values []float64, value float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	below, equal := 0, 0
+	for _, v := range values {
+		if v < value {
+			below++
+		} else if v == value {
+			equal++
+		}
+	}
+	return (float64(below) + float64(equal)/2) / float64(len(values)) * 100
+}
+
+// Median returns the middle of the values, or the average of the two middle values for an even number of values.
+func Median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
+
+func metricValues(population []Values, metric Metric) []float64 {
+	values := []float64{}
+	for _, v := range population {
+		values = append(values, v[metric])
+	}
+	return values
+}
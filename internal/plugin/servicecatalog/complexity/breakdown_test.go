@@ -0,0 +1,57 @@
+package complexity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercentile(t *testing.T) {
+	values := []float64{1, 2, 2, 3, 10}
+	assert.Equal(t, 0.0, Percentile(values, 0))
+	assert.Equal(t, 10.0, Percentile(values, 1))
+	assert.Equal(t, 40.0, Percentile(values, 2))
+	assert.Equal(t, 90.0, Percentile(values, 10))
+	assert.Equal(t, 100.0, Percentile(values, 11))
+	assert.Equal(t, 0.0, Percentile(nil, 5))
+}
+
+func TestMedian(t *testing.T) {
+	assert.Equal(t, 3.0, Median([]float64{5, 1, 3}))
+	assert.Equal(t, 2.5, Median([]float64{4, 1, 3, 2}))
+	assert.Equal(t, 0.0, Median(nil))
+}
+
+func TestExplain(t *testing.T) {
+	profile := Profile{
+		Name:          "test",
+		Normalization: NormalizationNone,
+		Scale:         1,
+		Metrics: []MetricWeight{
+			{Metric: FanIn, Weight: 2},
+			{Metric: JobCount, Weight: 1},
+		},
+	}
+	module := Values{FanIn: 3, JobCount: 1}
+	team := []Values{module, {FanIn: 1, JobCount: 0}, {FanIn: 0, JobCount: 5}}
+	population := append([]Values{{FanIn: 0, JobCount: 0}}, team...)
+
+	breakdown := Explain(profile, module, population, team)
+
+	assert.Equal(t, "test", breakdown.Profile)
+	assert.Equal(t, 7.0, breakdown.Score)
+	assert.Equal(t, 87.5, breakdown.Percentile)
+	assert.Equal(t, 5.0, breakdown.TeamMedianScore)
+	assert.Equal(t, []MetricBreakdown{
+		{
+			Contribution: Contribution{Metric: FanIn, Value: 3, Normalized: 3, Weight: 2, Contribution: 6},
+			Percentile:   87.5,
+			TeamMedian:   1,
+		},
+		{
+			Contribution: Contribution{Metric: JobCount, Value: 1, Normalized: 1, Weight: 1, Contribution: 1},
+			Percentile:   62.5,
+			TeamMedian:   1,
+		},
+	}, breakdown.Metrics)
+}
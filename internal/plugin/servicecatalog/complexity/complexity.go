@@ -0,0 +1,200 @@
+package complexity
+
+import (
+	"fmt"
+	"math"
+)
+
+// Metric is a measurable property of a module that can contribute to its complexity score.
+type Metric string
+
+const (
+	// LineCount is the number of lines of code of a module
+	LineCount Metric = "line_count"
+	// FileCount is the number of source files of a module
+	FileCount Metric = "file_count"
+	// DatabaseCount is the number of databases a module uses
+	DatabaseCount Metric = "database_count"
+	// TeamCount is the number of teams that work on a module
+	TeamCount Metric = "team_count"
+	// ExposedAPICount is the number of interfaces a module exposes
+	ExposedAPICount Metric = "exposed_api_count"
+	// ConsumedAPICount is the number of interfaces a module consumes
+	ConsumedAPICount Metric = "consumed_api_count"
+	// JobCount is the number of jobs a module runs
+	JobCount Metric = "job_count"
+	// FlowCount is the number of flows a module participates in
+	FlowCount Metric = "flow_count"
+	// KindCount is the number of application kinds of a module
+	KindCount Metric = "kind_count"
+	// DependencyCount is the number of gradle dependencies of a module
+	DependencyCount Metric = "dependency_count"
+	// FanIn is the number of distinct modules consuming an interface of a module
+	FanIn Metric = "fan_in"
+	// FanOut is the number of distinct modules a module consumes an interface of
+	FanOut Metric = "fan_out"
+)
+
+// Metrics lists all supported module metrics.
+var Metrics = []Metric{LineCount, FileCount, DatabaseCount, TeamCount, ExposedAPICount, ConsumedAPICount,
+	JobCount, FlowCount, KindCount, DependencyCount, FanIn, FanOut}
+
+const (
+	// MethodCount is the number of web-methods of an interface
+	MethodCount Metric = "method_count"
+	// ConsumerCount is the number of modules consuming an interface
+	ConsumerCount Metric = "consumer_count"
+	// ConsumingTeamCount is the number of distinct teams owning the modules consuming an interface
+	ConsumingTeamCount Metric = "consuming_team_count"
+	// OperationCount is the number of operations in the OpenAPI specification of an interface
+	OperationCount Metric = "operation_count"
+	// SchemaSize is the number of named schemas and their properties in the OpenAPI specification of an interface
+	SchemaSize Metric = "schema_size"
+)
+
+// InterfaceMetrics lists all supported interface metrics.
+var InterfaceMetrics = []Metric{MethodCount, ConsumerCount, ConsumingTeamCount, OperationCount, SchemaSize}
+
+// Normalization determines how the value of a metric is scaled before its weight is applied.
+type Normalization string
+
+const (
+	// NormalizationNone uses the value as is
+	NormalizationNone Normalization = "none"
+	// NormalizationMax divides the value by the largest value of that metric across all modules, resulting in 0..1
+	NormalizationMax Normalization = "max"
+	// NormalizationLog uses the natural logarithm of 1 + value, which dampens outliers
+	NormalizationLog Normalization = "log"
+)
+
+// Normalizations lists all supported normalizations.
+var Normalizations = []Normalization{NormalizationNone, NormalizationMax, NormalizationLog}
+
+// Values holds the value of each metric of a single module.
+type Values map[Metric]float64
+
+// MetricWeight configures how much a metric contributes to the complexity score.
+// The value of the metric is counted in whole units of Divisor, so a divisor of 1000 on line_count counts thousands of lines.
+type MetricWeight struct {
+	Metric  Metric  `yaml:"metric" json:"metric"`
+	Weight  float64 `yaml:"weight" json:"weight"`
+	Divisor float64 `yaml:"divisor,omitempty" json:"divisor,omitempty"`
+}
+
+// Profile is a named complexity scoring model. Only the metrics listed participate in the score.
+type Profile struct {
+	Name          string         `yaml:"name" json:"name"`
+	Description   string         `yaml:"description,omitempty" json:"description,omitempty"`
+	Normalization Normalization  `yaml:"normalization,omitempty" json:"normalization"`
+	Scale         float64        `yaml:"scale,omitempty" json:"scale"`
+	Metrics       []MetricWeight `yaml:"metrics" json:"metrics"`
+}
+
+// Contribution explains how much a single metric adds to the complexity score of a module.
+type Contribution struct {
+	Metric       Metric  `json:"metric"`
+	Value        float64 `json:"value"`
+	Normalized   float64 `json:"normalized"`
+	Weight       float64 `json:"weight"`
+	Contribution float64 `json:"contribution"`
+}
+
+// NeedsMaxima tells whether scoring requires the largest value of each metric across all modules.
+func (p Profile) NeedsMaxima() bool {
+	return p.Normalization == NormalizationMax
+}
+
+// Contributions returns the contribution of each metric of the profile to the complexity score.
+// The maxima are only used with max normalization.
+func (p Profile) Contributions(values Values, maxima Values) []Contribution {
+	contributions := []Contribution{}
+	for _, mw := range p.Metrics {
+		value := values[mw.Metric]
+		normalized := p.normalize(units(value, mw.Divisor), units(maxima[mw.Metric], mw.Divisor))
+		contributions = append(contributions, Contribution{
+			Metric:       mw.Metric,
+			Value:        value,
+			Normalized:   normalized,
+			Weight:       mw.Weight,
+			Contribution: normalized * mw.Weight * p.Scale,
+		})
+	}
+	return contributions
+}
+
+// Score returns the complexity score of a module, being the sum of the contributions of all metrics of the profile.
+func (p Profile) Score(values Values, maxima Values) float64 {
+	score := 0.0
+	for _, contribution := range p.Contributions(values, maxima) {
+		score += contribution.Contribution
+	}
+	return score
+}
+
+// Maxima returns the largest value of each metric across the given modules.
+func Maxima(population []Values) Values {
+	maxima := Values{}
+	for _, values := range population {
+		for metric, value := range values {
+			maxima[metric] = math.Max(maxima[metric], value)
+		}
+	}
+	return maxima
+}
+
+func (p Profile) normalize(value float64, maximum float64) float64 {
+	switch p.Normalization {
+	case NormalizationMax:
+		if maximum <= 0 {
+			return 0
+		}
+		return value / maximum
+	case NormalizationLog:
+		return math.Log1p(value)
+	default:
+		return value
+	}
+}
+
+func (p Profile) validate(metrics []Metric) error {
+	if p.Name == "" {
+		return fmt.Errorf("profile without name")
+	}
+	if !contains(Normalizations, p.Normalization) {
+		return fmt.Errorf("profile %s has unknown normalization %s", p.Name, p.Normalization)
+	}
+	if p.Scale <= 0 {
+		return fmt.Errorf("profile %s has non-positive scale %v", p.Name, p.Scale)
+	}
+	if len(p.Metrics) == 0 {
+		return fmt.Errorf("profile %s has no metrics", p.Name)
+	}
+	for _, mw := range p.Metrics {
+		if !contains(metrics, mw.Metric) {
+			return fmt.Errorf("profile %s has unknown metric %s", p.Name, mw.Metric)
+		}
+		if mw.Weight < 0 {
+			return fmt.Errorf("profile %s has negative weight for metric %s", p.Name, mw.Metric)
+		}
+		if mw.Divisor < 0 {
+			return fmt.Errorf("profile %s has negative divisor for metric %s", p.Name, mw.Metric)
+		}
+	}
+	return nil
+}
+
+func units(value float64, divisor float64) float64 {
+	if divisor <= 1 {
+		return value
+	}
+	return math.Floor(value / divisor)
+}
+
+func contains[T comparable](values []T, value T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package complexity
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultProfileMirrorsHistoricalScore(t *testing.T) {
+	profile, found := DefaultConfig().Profile("")
+	assert.True(t, found)
+
+	values := Values{LineCount: 12345, DatabaseCount: 2, TeamCount: 1, ExposedAPICount: 3, ConsumedAPICount: 4,
+		JobCount: 1, FlowCount: 2, KindCount: 1, FileCount: 99, DependencyCount: 50}
+
+	// (12*0.25 + 2*0.20 + 1*0.15 + 3*0.15 + 4*0.15 + 1*0.10 + 2*0.05 + 1*0.05) * 100
+	assert.InDelta(t, 485.0, profile.Score(values, nil), 0.0001)
+}
+
+func TestContributions(t *testing.T) {
+	profile := Profile{
+		Name:          "test",
+		Normalization: NormalizationMax,
+		Scale:         10,
+		Metrics: []MetricWeight{
+			{Metric: FanIn, Weight: 0.5},
+			{Metric: LineCount, Weight: 0.5, Divisor: 1000},
+		},
+	}
+	maxima := Maxima([]Values{
+		{FanIn: 4, LineCount: 2000},
+		{FanIn: 8, LineCount: 10999},
+	})
+	assert.Equal(t, Values{FanIn: 8, LineCount: 10999}, maxima)
+
+	contributions := profile.Contributions(Values{FanIn: 4, LineCount: 2000}, maxima)
+	assert.Equal(t, []Contribution{
+		{Metric: FanIn, Value: 4, Normalized: 0.5, Weight: 0.5, Contribution: 2.5},
+		{Metric: LineCount, Value: 2000, Normalized: 0.2, Weight: 0.5, Contribution: 1},
+	}, contributions)
+	assert.InDelta(t, 3.5, profile.Score(Values{FanIn: 4, LineCount: 2000}, maxima), 0.0001)
+
+	// without maxima max-normalized metrics do not contribute
+	assert.Equal(t, 0.0, profile.Score(Values{FanIn: 4}, Values{}))
+}
+
+func TestLogNormalization(t *testing.T) {
+	profile := Profile{Name: "log", Normalization: NormalizationLog, Scale: 1, Metrics: []MetricWeight{{Metric: FanOut, Weight: 2}}}
+	assert.InDelta(t, 2*math.Log(11), profile.Score(Values{FanOut: 10}, nil), 0.0001)
+	assert.False(t, profile.NeedsMaxima())
+}
@@ -0,0 +1,185 @@
+package complexity
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DefaultProfileName is the name of the built-in profile that mirrors the historical complexity score
+	DefaultProfileName = "default"
+	// InterfaceProfileName is the name of the built-in profile used to score interfaces
+	InterfaceProfileName = "interface"
+
+	defaultScale = 100
+)
+
+// Config is a set of named scoring profiles for modules, one of which is used when no profile is asked for,
+// and the profile used to score interfaces.
+type Config struct {
+	DefaultProfile   string    `yaml:"default_profile,omitempty"`
+	Profiles         []Profile `yaml:"profiles"`
+	InterfaceProfile Profile   `yaml:"interface_profile,omitempty"`
+}
+
+// DefaultConfig returns the built-in scoring profiles.
+func DefaultConfig() Config {
+	return Config{
+		DefaultProfile: DefaultProfileName,
+		Profiles: []Profile{
+			{
+				Name:          DefaultProfileName,
+				Description:   "Size and responsibilities of a module, using the historical weights",
+				Normalization: NormalizationNone,
+				Scale:         defaultScale,
+				Metrics: []MetricWeight{
+					{Metric: LineCount, Weight: 0.25, Divisor: 1000},
+					{Metric: DatabaseCount, Weight: 0.20},
+					{Metric: TeamCount, Weight: 0.15},
+					{Metric: ExposedAPICount, Weight: 0.15},
+					{Metric: ConsumedAPICount, Weight: 0.15},
+					{Metric: JobCount, Weight: 0.10},
+					{Metric: FlowCount, Weight: 0.05},
+					{Metric: KindCount, Weight: 0.05},
+				},
+			},
+			{
+				Name:          "coupling",
+				Description:   "How entangled a module is with the rest of the landscape",
+				Normalization: NormalizationMax,
+				Scale:         defaultScale,
+				Metrics: []MetricWeight{
+					{Metric: FanIn, Weight: 0.30},
+					{Metric: FanOut, Weight: 0.30},
+					{Metric: DependencyCount, Weight: 0.20},
+					{Metric: ExposedAPICount, Weight: 0.10},
+					{Metric: ConsumedAPICount, Weight: 0.10},
+				},
+			},
+			{
+				Name:          "balanced",
+				Description:   "All metrics, each relative to the largest module for that metric",
+				Normalization: NormalizationMax,
+				Scale:         defaultScale,
+				Metrics: []MetricWeight{
+					{Metric: LineCount, Weight: 0.15},
+					{Metric: FileCount, Weight: 0.05},
+					{Metric: DatabaseCount, Weight: 0.10},
+					{Metric: TeamCount, Weight: 0.10},
+					{Metric: ExposedAPICount, Weight: 0.10},
+					{Metric: ConsumedAPICount, Weight: 0.10},
+					{Metric: JobCount, Weight: 0.05},
+					{Metric: FlowCount, Weight: 0.05},
+					{Metric: KindCount, Weight: 0.05},
+					{Metric: DependencyCount, Weight: 0.05},
+					{Metric: FanIn, Weight: 0.10},
+					{Metric: FanOut, Weight: 0.10},
+				},
+			},
+		},
+		InterfaceProfile: defaultInterfaceProfile(),
+	}
+}
+
+func defaultInterfaceProfile() Profile {
+	return Profile{
+		Name:          InterfaceProfileName,
+		Description:   "Size of an interface and how widely it is used, each relative to the largest interface",
+		Normalization: NormalizationMax,
+		Scale:         defaultScale,
+		Metrics: []MetricWeight{
+			{Metric: MethodCount, Weight: 0.25},
+			{Metric: ConsumerCount, Weight: 0.25},
+			{Metric: ConsumingTeamCount, Weight: 0.20},
+			{Metric: OperationCount, Weight: 0.15},
+			{Metric: SchemaSize, Weight: 0.15},
+		},
+	}
+}
+
+// LoadConfig reads scoring profiles from a YAML file.
+// Scale defaults to 100 and normalization to none. Without default_profile the first profile is the default.
+// Without interface_profile the built-in interface profile is used.
+func LoadConfig(filename string) (Config, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return Config{}, fmt.Errorf("error reading complexity config %s: %w", filename, err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses scoring profiles from YAML.
+func ParseConfig(data []byte) (Config, error) {
+	config := Config{}
+	err := yaml.Unmarshal(data, &config)
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing complexity config: %w", err)
+	}
+	if len(config.Profiles) == 0 {
+		if len(config.InterfaceProfile.Metrics) == 0 {
+			return Config{}, fmt.Errorf("complexity config has no profiles")
+		}
+		// only the interface profile is configured: keep the built-in module profiles
+		defaults := DefaultConfig()
+		config.DefaultProfile, config.Profiles = defaults.DefaultProfile, defaults.Profiles
+	}
+
+	seen := map[string]bool{}
+	for i, profile := range config.Profiles {
+		config.Profiles[i] = withDefaults(profile)
+		err = config.Profiles[i].validate(Metrics)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid complexity config: %w", err)
+		}
+		if seen[profile.Name] {
+			return Config{}, fmt.Errorf("invalid complexity config: duplicate profile %s", profile.Name)
+		}
+		seen[profile.Name] = true
+	}
+
+	if len(config.InterfaceProfile.Metrics) == 0 {
+		config.InterfaceProfile = defaultInterfaceProfile()
+	}
+	if config.InterfaceProfile.Name == "" {
+		config.InterfaceProfile.Name = InterfaceProfileName
+	}
+	config.InterfaceProfile = withDefaults(config.InterfaceProfile)
+	err = config.InterfaceProfile.validate(InterfaceMetrics)
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid complexity config: %w", err)
+	}
+
+	if config.DefaultProfile == "" {
+		config.DefaultProfile = config.Profiles[0].Name
+	}
+	if !seen[config.DefaultProfile] {
+		return Config{}, fmt.Errorf("invalid complexity config: unknown default profile %s", config.DefaultProfile)
+	}
+
+	return config, nil
+}
+
+func withDefaults(profile Profile) Profile {
+	if profile.Normalization == "" {
+		profile.Normalization = NormalizationNone
+	}
+	if profile.Scale == 0 {
+		profile.Scale = defaultScale
+	}
+	return profile
+}
+
+// Profile returns the profile with the given name. An empty name returns the default profile.
+func (c Config) Profile(name string) (Profile, bool) {
+	if name == "" {
+		name = c.DefaultProfile
+	}
+	for _, profile := range c.Profiles {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+	return Profile{}, false
+}
@@ -0,0 +1,118 @@
+package complexity
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConfig(t *testing.T) {
+	config, err := ParseConfig([]byte(`
+profiles:
+  - name: fan
+    description: Only coupling
+    normalization: log
+    metrics:
+      - metric: fan_in
+        weight: 0.5
+      - metric: fan_out
+        weight: 0.5
+  - name: size
+    scale: 1
+    metrics:
+      - metric: line_count
+        weight: 1
+        divisor: 1000
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, "fan", config.DefaultProfile)
+
+	profile, found := config.Profile("")
+	assert.True(t, found)
+	assert.Equal(t, "fan", profile.Name)
+	assert.Equal(t, NormalizationLog, profile.Normalization)
+	assert.Equal(t, 100.0, profile.Scale)
+
+	profile, found = config.Profile("size")
+	assert.True(t, found)
+	assert.Equal(t, NormalizationNone, profile.Normalization)
+	assert.Equal(t, 3.0, profile.Score(Values{LineCount: 3500}, nil))
+
+	_, found = config.Profile("unknown")
+	assert.False(t, found)
+
+	assert.Equal(t, defaultInterfaceProfile(), config.InterfaceProfile)
+}
+
+func TestParseConfigWithInterfaceProfile(t *testing.T) {
+	config, err := ParseConfig([]byte(`
+profiles:
+  - name: a
+    metrics: [{metric: fan_in, weight: 1}]
+interface_profile:
+  normalization: log
+  metrics:
+    - metric: consumer_count
+      weight: 1
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, Profile{
+		Name:          InterfaceProfileName,
+		Normalization: NormalizationLog,
+		Scale:         100,
+		Metrics:       []MetricWeight{{Metric: ConsumerCount, Weight: 1}},
+	}, config.InterfaceProfile)
+}
+
+func TestParseConfigWithOnlyInterfaceProfile(t *testing.T) {
+	config, err := ParseConfig([]byte(`
+interface_profile:
+  metrics:
+    - metric: consumer_count
+      weight: 1
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultConfig().Profiles, config.Profiles)
+	assert.Equal(t, DefaultConfig().DefaultProfile, config.DefaultProfile)
+	assert.Equal(t, []MetricWeight{{Metric: ConsumerCount, Weight: 1}}, config.InterfaceProfile.Metrics)
+}
+
+func TestParseConfigInvalid(t *testing.T) {
+	for config, message := range map[string]string{
+		`profiles: []`: "no profiles",
+		`profiles: [{name: a, metrics: [{metric: colour, weight: 1}]}]`:                              "unknown metric colour",
+		`profiles: [{name: a, normalization: zscore, metrics: [{metric: fan_in, weight: 1}]}]`:       "unknown normalization zscore",
+		`profiles: [{name: a, metrics: [{metric: fan_in, weight: -1}]}]`:                             "negative weight",
+		`profiles: [{name: a, metrics: []}]`:                                                         "has no metrics",
+		`{default_profile: b, profiles: [{name: a, metrics: [{metric: fan_in, weight: 1}]}]}`:        "unknown default profile b",
+		`profiles: [{name: a, metrics: [{metric: fan_in}]}, {name: a, metrics: [{metric: fan_in}]}]`: "duplicate profile a",
+		`profiles: {`: "error parsing",
+		`{profiles: [{name: a, metrics: [{metric: method_count, weight: 1}]}]}`:                                                        "unknown metric method_count",
+		`{profiles: [{name: a, metrics: [{metric: fan_in, weight: 1}]}], interface_profile: {metrics: [{metric: fan_in, weight: 1}]}}`: "profile interface has unknown metric fan_in",
+	} {
+		_, err := ParseConfig([]byte(config))
+		assert.ErrorContains(t, err, message, config)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "complexity.yaml")
+	err := os.WriteFile(filename, []byte(`{profiles: [{name: a, metrics: [{metric: job_count, weight: 1}]}]}`), 0o644)
+	assert.NoError(t, err)
+
+	config, err := LoadConfig(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", config.DefaultProfile)
+
+	_, err = LoadConfig(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestDefaultConfigIsValid(t *testing.T) {
+	for _, profile := range DefaultConfig().Profiles {
+		assert.NoError(t, profile.validate(Metrics))
+	}
+	assert.NoError(t, DefaultConfig().InterfaceProfile.validate(InterfaceMetrics))
+}
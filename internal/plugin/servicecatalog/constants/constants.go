@@ -3,4 +3,18 @@ package constants
 const (
 	// CatalogDatabaseFilenameKey offers a typestrong key for the catalog database filename
 	CatalogDatabaseFilenameKey = "catalog-databasefile"
+	// BaselineCatalogDatabaseFilenameKey offers a typestrong key for the filename of an older catalog database to compare with
+	BaselineCatalogDatabaseFilenameKey = "baseline-catalog-databasefile"
+	// SpecRootDirKey offers a typestrong key for the directory the interface specifications are read from
+	SpecRootDirKey = "spec-rootdir"
+	// BaselineSpecRootDirKey offers a typestrong key for the directory the interface specifications of the older catalog are read from
+	BaselineSpecRootDirKey = "baseline-spec-rootdir"
+	// ComplexityConfigFilenameKey offers a typestrong key for the filename of the complexity scoring profiles
+	ComplexityConfigFilenameKey = "complexity-config"
+	// EnableQueryCatalogKey offers a typestrong key for enabling read-only SQL queries on the catalog database
+	EnableQueryCatalogKey = "enable-query-catalog"
+	// SemanticModelFilenameKey offers a typestrong key for the filename the trained semantic model is kept in
+	SemanticModelFilenameKey = "semantic-model-file"
+	// SearchWeightsKey offers a typestrong key for the weights of the categories of suggested candidates
+	SearchWeightsKey = "search-weights"
 )
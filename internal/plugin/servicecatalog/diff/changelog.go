@@ -0,0 +1,80 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Changelog renders the diff as a human readable markdown document.
+func (d Diff) Changelog() string {
+	sb := strings.Builder{}
+	fmt.Fprintf(&sb, "# Service catalog changes %s -> %s\n", orUnknown(d.OldVersion), orUnknown(d.NewVersion))
+
+	if d.IsEmpty() {
+		sb.WriteString("\nNo changes.\n")
+		return sb.String()
+	}
+
+	writeEntities(&sb, "Modules", d.Modules)
+	writeAttributes(&sb, "Team ownership", d.TeamOwnership)
+	writeEntities(&sb, "Interfaces", d.Interfaces)
+	writeMemberships(&sb, "Interface exposers", d.InterfaceExposers)
+	writeMemberships(&sb, "Interface methods", d.InterfaceMethods)
+	writeMemberships(&sb, "Interface consumers", d.InterfaceConsumers)
+	writeEntities(&sb, "Databases", d.Databases)
+	writeMemberships(&sb, "Database consumers", d.DatabaseConsumers)
+	writeEntities(&sb, "Flows", d.Flows)
+	writeMemberships(&sb, "Flow participants", d.FlowParticipants)
+
+	return sb.String()
+}
+
+func writeEntities(sb *strings.Builder, title string, diff EntityDiff) {
+	if diff.isEmpty() {
+		return
+	}
+	fmt.Fprintf(sb, "\n## %s\n\n", title)
+	for _, id := range diff.Added {
+		fmt.Fprintf(sb, "- added `%s`\n", id)
+	}
+	for _, id := range diff.Removed {
+		fmt.Fprintf(sb, "- removed `%s`\n", id)
+	}
+	for _, change := range diff.Changed {
+		fmt.Fprintf(sb, "- changed %s of `%s`: %q -> %q\n", change.Attribute, change.ID, change.Old, change.New)
+	}
+}
+
+func writeAttributes(sb *strings.Builder, title string, changes []AttributeChange) {
+	if len(changes) == 0 {
+		return
+	}
+	fmt.Fprintf(sb, "\n## %s\n\n", title)
+	for _, change := range changes {
+		fmt.Fprintf(sb, "- `%s`: %s -> %s\n", change.ID, orUnknown(change.Old), orUnknown(change.New))
+	}
+}
+
+func writeMemberships(sb *strings.Builder, title string, changes []MembershipChange) {
+	if len(changes) == 0 {
+		return
+	}
+	fmt.Fprintf(sb, "\n## %s\n\n", title)
+	for _, change := range changes {
+		parts := []string{}
+		if len(change.Added) > 0 {
+			parts = append(parts, "added "+strings.Join(change.Added, ", "))
+		}
+		if len(change.Removed) > 0 {
+			parts = append(parts, "removed "+strings.Join(change.Removed, ", "))
+		}
+		fmt.Fprintf(sb, "- `%s`: %s\n", change.ID, strings.Join(parts, "; "))
+	}
+}
+
+func orUnknown(value string) string {
+	if value == "" {
+		return "unknown"
+	}
+	return value
+}
@@ -0,0 +1,279 @@
+package diff
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+// Diff describes what changed between two generations of the service catalog.
+type Diff struct {
+	OldVersion         string             `json:"oldVersion"`
+	NewVersion         string             `json:"newVersion"`
+	Modules            EntityDiff         `json:"modules"`
+	TeamOwnership      []AttributeChange  `json:"teamOwnership"`
+	Interfaces         EntityDiff         `json:"interfaces"`
+	InterfaceExposers  []MembershipChange `json:"interfaceExposers"`
+	InterfaceMethods   []MembershipChange `json:"interfaceMethods"`
+	InterfaceConsumers []MembershipChange `json:"interfaceConsumers"`
+	Databases          EntityDiff         `json:"databases"`
+	DatabaseConsumers  []MembershipChange `json:"databaseConsumers"`
+	Flows              EntityDiff         `json:"flows"`
+	FlowParticipants   []MembershipChange `json:"flowParticipants"`
+}
+
+// EntityDiff lists the entities of a kind that were added, removed or had one of their attributes changed.
+type EntityDiff struct {
+	Added   []string          `json:"added"`
+	Removed []string          `json:"removed"`
+	Changed []AttributeChange `json:"changed,omitempty"`
+}
+
+// AttributeChange describes the old and new value of an attribute of an entity.
+type AttributeChange struct {
+	ID        string `json:"id"`
+	Attribute string `json:"attribute"`
+	Old       string `json:"old"`
+	New       string `json:"new"`
+}
+
+// MembershipChange describes the members that were added to or removed from an entity, like the consumers of an interface.
+type MembershipChange struct {
+	ID      string   `json:"id"`
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+}
+
+// IsEmpty tells whether nothing changed between the two catalog generations.
+func (d Diff) IsEmpty() bool {
+	return d.Modules.isEmpty() && d.Interfaces.isEmpty() && d.Databases.isEmpty() && d.Flows.isEmpty() &&
+		len(d.TeamOwnership) == 0 && len(d.InterfaceExposers) == 0 && len(d.InterfaceMethods) == 0 &&
+		len(d.InterfaceConsumers) == 0 && len(d.DatabaseConsumers) == 0 && len(d.FlowParticipants) == 0
+}
+
+func (e EntityDiff) isEmpty() bool {
+	return len(e.Added) == 0 && len(e.Removed) == 0 && len(e.Changed) == 0
+}
+
+// Compare reports the differences between an old and a new generation of the service catalog.
+func Compare(ctx context.Context, oldCatalog, newCatalog repo.Cataloger) (Diff, error) {
+	before, err := load(ctx, oldCatalog)
+	if err != nil {
+		return Diff{}, fmt.Errorf("error loading old catalog: %w", err)
+	}
+	after, err := load(ctx, newCatalog)
+	if err != nil {
+		return Diff{}, fmt.Errorf("error loading new catalog: %w", err)
+	}
+
+	diff := Diff{
+		OldVersion:         before.version,
+		NewVersion:         after.version,
+		Modules:            compareEntities(before.modules, after.modules),
+		TeamOwnership:      []AttributeChange{},
+		Interfaces:         compareEntities(before.interfaces, after.interfaces),
+		InterfaceExposers:  compareMemberships(before.interfaceExposers, after.interfaceExposers),
+		InterfaceMethods:   compareMemberships(before.interfaceMethods, after.interfaceMethods),
+		InterfaceConsumers: compareMemberships(before.interfaceConsumers, after.interfaceConsumers),
+		Databases:          compareEntities(before.databases, after.databases),
+		DatabaseConsumers:  compareMemberships(before.databaseConsumers, after.databaseConsumers),
+		Flows:              compareEntities(before.flows, after.flows),
+		FlowParticipants:   compareMemberships(before.flowParticipants, after.flowParticipants),
+	}
+
+	// Ownership changes are reported separately from the other module attributes
+	changed := []AttributeChange{}
+	for _, change := range diff.Modules.Changed {
+		if change.Attribute == "team" {
+			diff.TeamOwnership = append(diff.TeamOwnership, change)
+		} else {
+			changed = append(changed, change)
+		}
+	}
+	diff.Modules.Changed = changed
+
+	return diff, nil
+}
+
+// snapshot holds the comparable state of a single catalog generation.
+type snapshot struct {
+	version            string
+	modules            map[string]map[string]string // attributes keyed on module-id
+	interfaces         map[string]map[string]string // attributes keyed on interface-id
+	interfaceExposers  map[string][]string
+	interfaceMethods   map[string][]string
+	interfaceConsumers map[string][]string
+	databases          map[string]map[string]string
+	databaseConsumers  map[string][]string
+	flows              map[string]map[string]string
+	flowParticipants   map[string][]string
+}
+
+func load(ctx context.Context, catalog repo.Cataloger) (snapshot, error) {
+	s := snapshot{
+		modules:            map[string]map[string]string{},
+		interfaces:         map[string]map[string]string{},
+		interfaceExposers:  map[string][]string{},
+		interfaceMethods:   map[string][]string{},
+		interfaceConsumers: map[string][]string{},
+		databases:          map[string]map[string]string{},
+		databaseConsumers:  map[string][]string{},
+		flows:              map[string]map[string]string{},
+		flowParticipants:   map[string][]string{},
+	}
+
+	modules, err := catalog.ListModules(ctx, "")
+	if err != nil {
+		return s, err
+	}
+	for _, module := range modules {
+		s.modules[module.ModuleID] = map[string]string{
+			"name":        module.Name,
+			"description": module.Description,
+			"team":        module.Team,
+		}
+		if module.Version > s.version {
+			s.version = module.Version
+		}
+	}
+
+	interfaces, err := catalog.ListInterfaces(ctx, "")
+	if err != nil {
+		return s, err
+	}
+	for _, iface := range interfaces {
+		if _, found := s.interfaces[iface.InterfaceID]; !found {
+			s.interfaces[iface.InterfaceID] = map[string]string{
+				"description": iface.Description,
+				"kind":        iface.Kind,
+			}
+			s.interfaceExposers[iface.InterfaceID] = []string{}
+			s.interfaceMethods[iface.InterfaceID] = []string{}
+			s.interfaceConsumers[iface.InterfaceID] = []string{}
+		}
+		if iface.ModuleID != "" {
+			s.interfaceExposers[iface.InterfaceID] = append(s.interfaceExposers[iface.InterfaceID], iface.ModuleID)
+		}
+	}
+
+	databases, err := catalog.ListDatabases(ctx)
+	if err != nil {
+		return s, err
+	}
+	for _, databaseID := range databases {
+		s.databases[databaseID] = map[string]string{}
+		s.databaseConsumers[databaseID] = []string{}
+	}
+
+	flows, err := catalog.ListFlows(ctx)
+	if err != nil {
+		return s, err
+	}
+	for _, flowID := range flows {
+		s.flows[flowID] = map[string]string{}
+		s.flowParticipants[flowID] = []string{}
+	}
+
+	// Members are read per relation rather than per entity: a catalog has thousands of interfaces
+	for relation, memberships := range map[repo.Relation]map[string][]string{
+		repo.RelationInterfaceMethods:   s.interfaceMethods,
+		repo.RelationInterfaceConsumers: s.interfaceConsumers,
+		repo.RelationDatabaseConsumers:  s.databaseConsumers,
+		repo.RelationFlowParticipants:   s.flowParticipants,
+	} {
+		members, err := catalog.ListRelationMembers(ctx, relation)
+		if err != nil {
+			return s, err
+		}
+		for _, member := range members {
+			if _, found := memberships[member.ID]; found {
+				memberships[member.ID] = append(memberships[member.ID], member.MemberID)
+			}
+		}
+	}
+
+	return s, nil
+}
+
+func compareEntities(before, after map[string]map[string]string) EntityDiff {
+	diff := EntityDiff{
+		Added:   []string{},
+		Removed: []string{},
+		Changed: []AttributeChange{},
+	}
+	for id := range after {
+		if _, found := before[id]; !found {
+			diff.Added = append(diff.Added, id)
+		}
+	}
+	for _, id := range sortedKeys(before) {
+		attributes, found := after[id]
+		if !found {
+			diff.Removed = append(diff.Removed, id)
+			continue
+		}
+		for _, attribute := range sortedKeys(before[id]) {
+			if before[id][attribute] != attributes[attribute] {
+				diff.Changed = append(diff.Changed, AttributeChange{
+					ID:        id,
+					Attribute: attribute,
+					Old:       before[id][attribute],
+					New:       attributes[attribute],
+				})
+			}
+		}
+	}
+	sort.Strings(diff.Added)
+
+	return diff
+}
+
+// compareMemberships compares the members of entities that exist in both generations.
+// Members of added or removed entities are not repeated.
+func compareMemberships(before, after map[string][]string) []MembershipChange {
+	changes := []MembershipChange{}
+	for _, id := range sortedKeys(before) {
+		members, found := after[id]
+		if !found {
+			continue
+		}
+		change := MembershipChange{
+			ID:      id,
+			Added:   difference(members, before[id]),
+			Removed: difference(before[id], members),
+		}
+		if len(change.Added) > 0 || len(change.Removed) > 0 {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// difference returns the sorted values of a that are not in b.
+func difference(a, b []string) []string {
+	inB := map[string]bool{}
+	for _, value := range b {
+		inB[value] = true
+	}
+	result := []string{}
+	seen := map[string]bool{}
+	for _, value := range a {
+		if !inB[value] && !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,124 @@
+package diff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+type catalogContent struct {
+	modules    []repo.Module
+	interfaces []repo.Interface
+	methods    map[string][]string
+	consumers  map[string][]string
+	databases  map[string][]string
+	flows      map[string][]string
+}
+
+func mockCatalog(ctrl *gomock.Controller, content catalogContent) repo.Cataloger {
+	catalog := repo.NewMockCataloger(ctrl)
+	catalog.EXPECT().ListModules(gomock.Any(), "").Return(content.modules, nil).AnyTimes()
+	catalog.EXPECT().ListInterfaces(gomock.Any(), "").Return(content.interfaces, nil).AnyTimes()
+	catalog.EXPECT().ListDatabases(gomock.Any()).Return(sortedKeys(content.databases), nil).AnyTimes()
+	catalog.EXPECT().ListFlows(gomock.Any()).Return(sortedKeys(content.flows), nil).AnyTimes()
+	for relation, memberships := range map[repo.Relation]map[string][]string{
+		repo.RelationInterfaceMethods:   content.methods,
+		repo.RelationInterfaceConsumers: content.consumers,
+		repo.RelationDatabaseConsumers:  content.databases,
+		repo.RelationFlowParticipants:   content.flows,
+	} {
+		catalog.EXPECT().ListRelationMembers(gomock.Any(), relation).Return(relationMembers(memberships), nil).AnyTimes()
+	}
+	return catalog
+}
+
+func relationMembers(memberships map[string][]string) []repo.RelationMember {
+	members := []repo.RelationMember{}
+	for _, id := range sortedKeys(memberships) {
+		for _, memberID := range memberships[id] {
+			members = append(members, repo.RelationMember{ID: id, MemberID: memberID})
+		}
+	}
+	return members
+}
+
+func TestCompare(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	before := mockCatalog(ctrl, catalogContent{
+		modules: []repo.Module{
+			{Version: "2025-01", ModuleID: "checkout", Name: "Checkout", Team: "team-a"},
+			{Version: "2025-01", ModuleID: "legacy", Name: "Legacy", Team: "team-b"},
+		},
+		interfaces: []repo.Interface{
+			{ModuleID: "checkout", InterfaceID: "CheckoutService", Kind: "rpl"},
+			{ModuleID: "legacy", InterfaceID: "LegacyService", Kind: "rpl"},
+		},
+		methods:   map[string][]string{"CheckoutService": {"payments", "paymentMethods"}},
+		consumers: map[string][]string{"CheckoutService": {"legacy"}},
+		databases: map[string][]string{"checkoutdb": {"checkout"}},
+		flows:     map[string][]string{"payment": {"checkout", "legacy"}},
+	})
+	after := mockCatalog(ctrl, catalogContent{
+		modules: []repo.Module{
+			{Version: "2025-02", ModuleID: "checkout", Name: "Checkout", Team: "team-c"},
+			{Version: "2025-02", ModuleID: "sessions", Name: "Sessions", Team: "team-a"},
+		},
+		interfaces: []repo.Interface{
+			{ModuleID: "checkout", InterfaceID: "CheckoutService", Kind: "openapi"},
+			{ModuleID: "sessions", InterfaceID: "CheckoutService", Kind: "openapi"},
+		},
+		methods:   map[string][]string{"CheckoutService": {"payments", "sessions"}},
+		consumers: map[string][]string{"CheckoutService": {"sessions"}},
+		databases: map[string][]string{"checkoutdb": {"checkout", "sessions"}, "sessiondb": {"sessions"}},
+		flows:     map[string][]string{"payment": {"checkout", "sessions"}},
+	})
+
+	diff, err := Compare(context.Background(), before, after)
+	assert.NoError(t, err)
+	assert.False(t, diff.IsEmpty())
+	assert.Equal(t, "2025-01", diff.OldVersion)
+	assert.Equal(t, "2025-02", diff.NewVersion)
+	assert.Equal(t, EntityDiff{Added: []string{"sessions"}, Removed: []string{"legacy"}, Changed: []AttributeChange{}}, diff.Modules)
+	assert.Equal(t, []AttributeChange{{ID: "checkout", Attribute: "team", Old: "team-a", New: "team-c"}}, diff.TeamOwnership)
+	assert.Equal(t, EntityDiff{
+		Added:   []string{},
+		Removed: []string{"LegacyService"},
+		Changed: []AttributeChange{{ID: "CheckoutService", Attribute: "kind", Old: "rpl", New: "openapi"}},
+	}, diff.Interfaces)
+	assert.Equal(t, []MembershipChange{{ID: "CheckoutService", Added: []string{"sessions"}, Removed: []string{}}}, diff.InterfaceExposers)
+	assert.Equal(t, []MembershipChange{{ID: "CheckoutService", Added: []string{"sessions"}, Removed: []string{"paymentMethods"}}}, diff.InterfaceMethods)
+	assert.Equal(t, []MembershipChange{{ID: "CheckoutService", Added: []string{"sessions"}, Removed: []string{"legacy"}}}, diff.InterfaceConsumers)
+	assert.Equal(t, EntityDiff{Added: []string{"sessiondb"}, Removed: []string{}, Changed: []AttributeChange{}}, diff.Databases)
+	assert.Equal(t, []MembershipChange{{ID: "checkoutdb", Added: []string{"sessions"}, Removed: []string{}}}, diff.DatabaseConsumers)
+	assert.Equal(t, []MembershipChange{{ID: "payment", Added: []string{"sessions"}, Removed: []string{"legacy"}}}, diff.FlowParticipants)
+
+	changelog := diff.Changelog()
+	assert.Contains(t, changelog, "# Service catalog changes 2025-01 -> 2025-02\n")
+	assert.Contains(t, changelog, "\n## Modules\n\n- added `sessions`\n- removed `legacy`\n")
+	assert.Contains(t, changelog, "\n## Team ownership\n\n- `checkout`: team-a -> team-c\n")
+	assert.Contains(t, changelog, "- `CheckoutService`: added sessions; removed paymentMethods\n")
+	assert.NotContains(t, changelog, "## Flows\n")
+}
+
+func TestCompareUnchanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	content := catalogContent{
+		modules:    []repo.Module{{Version: "2025-01", ModuleID: "checkout", Team: "team-a"}},
+		interfaces: []repo.Interface{{ModuleID: "checkout", InterfaceID: "CheckoutService"}},
+		databases:  map[string][]string{"checkoutdb": {"checkout"}},
+		flows:      map[string][]string{},
+	}
+
+	diff, err := Compare(context.Background(), mockCatalog(ctrl, content), mockCatalog(ctrl, content))
+	assert.NoError(t, err)
+	assert.True(t, diff.IsEmpty())
+	assert.Contains(t, diff.Changelog(), "No changes.")
+}
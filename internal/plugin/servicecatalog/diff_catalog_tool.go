@@ -0,0 +1,51 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/diff"
+)
+
+// NewDiffCatalogTool returns the MCP tool definition and its handler for comparing the catalog with an older generation.
+func (h *mcpHandler) diffCatalogTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"diff_catalog",
+			mcp.WithDescription("Compares the current service catalog with an older generation of the catalog. "+
+				"Reports added, removed and changed modules, interfaces, methods, consumers, databases, flows and team ownership."),
+			mcp.WithString("format", mcp.Enum("structured", "changelog"), mcp.DefaultString("structured"),
+				mcp.Description("Return the differences as structured data or as a markdown changelog")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[diff.Diff](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			format := request.GetString("format", "structured")
+			if format != "structured" && format != "changelog" {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid format %s", format),
+						"format",
+						"Use structured or changelog")), nil
+			}
+
+			// call business logic
+			differences, err := diff.Compare(ctx, h.baseline, h.repo)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error comparing catalogs: %s", err))), nil
+			}
+
+			if format == "changelog" {
+				return mcp.NewToolResultText(differences.Changelog()), nil
+			}
+			return mcp.NewToolResultJSON[diff.Diff](differences)
+		},
+	}
+}
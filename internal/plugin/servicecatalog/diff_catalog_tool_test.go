@@ -0,0 +1,121 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func expectCatalogContent(catalog *repo.MockCataloger, modules []repo.Module) {
+	catalog.EXPECT().ListModules(gomock.Any(), "").Return(modules, nil)
+	catalog.EXPECT().ListInterfaces(gomock.Any(), "").Return([]repo.Interface{}, nil)
+	catalog.EXPECT().ListDatabases(gomock.Any()).Return([]string{}, nil)
+	catalog.EXPECT().ListFlows(gomock.Any()).Return([]string{}, nil)
+	catalog.EXPECT().ListRelationMembers(gomock.Any(), gomock.Any()).Return([]repo.RelationMember{}, nil).Times(4)
+}
+
+func TestDiffCatalogTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	baseline := repo.NewMockCataloger(ctrl)
+	expectCatalogContent(baseline, []repo.Module{{Version: "v1", ModuleID: "module1", Team: "team1"}})
+
+	repository := repo.NewMockCataloger(ctrl)
+	expectCatalogContent(repository, []repo.Module{{Version: "v2", ModuleID: "module1", Team: "team2"}, {Version: "v2", ModuleID: "module2"}})
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx, WithBaselineCatalog(baseline)).diffCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("diff_catalog", nil))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"oldVersion":"v1","newVersion":"v2"`)
+	assert.Contains(t, textResult.Text, `"modules":{"added":["module2"],"removed":[]}`)
+	assert.Contains(t, textResult.Text, `"teamOwnership":[{"id":"module1","attribute":"team","old":"team1","new":"team2"}]`)
+}
+
+func TestDiffCatalogTool_Changelog(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	baseline := repo.NewMockCataloger(ctrl)
+	expectCatalogContent(baseline, []repo.Module{{Version: "v1", ModuleID: "module1"}})
+
+	repository := repo.NewMockCataloger(ctrl)
+	expectCatalogContent(repository, []repo.Module{{Version: "v2"}})
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx, WithBaselineCatalog(baseline)).diffCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("diff_catalog", map[string]interface{}{
+		"format": "changelog",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "# Service catalog changes v1 -> v2")
+	assert.Contains(t, textResult.Text, "- removed `module1`")
+}
+
+func TestDiffCatalogTool_InvalidFormat(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	baseline := repo.NewMockCataloger(ctrl)
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx, WithBaselineCatalog(baseline)).diffCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("diff_catalog", map[string]interface{}{
+		"format": "yaml",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Invalid format yaml")
+}
+
+func TestDiffCatalogTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	baseline := repo.NewMockCataloger(ctrl)
+	baseline.EXPECT().ListModules(gomock.Any(), "").Return(nil, errors.New("database not yet opened"))
+
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx, WithBaselineCatalog(baseline)).diffCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("diff_catalog", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error comparing catalogs: error loading old catalog: database not yet opened")
+}
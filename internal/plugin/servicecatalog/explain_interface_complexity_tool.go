@@ -0,0 +1,76 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
+)
+
+// NewExplainInterfaceComplexityTool returns the MCP tool definition and its handler for explaining the complexity score of an interface.
+func (h *mcpHandler) explainInterfaceComplexityTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"explain_interface_complexity",
+			mcp.WithDescription("Explains the complexity score of an interface (=web-api): the contribution of each metric (methods, consuming modules, consuming teams, OpenAPI operations and schema size), "+
+				"the percentile of the score and of each metric across all interfaces, and the median score and metric values of the interfaces exposed by the same team."),
+			mcp.WithString("interface_id", mcp.Required(), mcp.Description("The ID of the interface to explain the complexity score of")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[InterfaceComplexityBreakdown](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			interfaceID, err := request.RequireString("interface_id")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing interface_id",
+						"interface_id",
+						"Use a valid interface identifier")), nil
+			}
+
+			// call business logic
+			interfaces, values, profile, err := h.listInterfaceComplexity(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error explaining complexity of interface %s: %s", interfaceID, err))), nil
+			}
+
+			subject := -1
+			for idx, iface := range interfaces {
+				if iface.InterfaceID == interfaceID {
+					subject = idx
+					break
+				}
+			}
+			if subject < 0 {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Interface with ID %s not found", interfaceID),
+						"interface_id",
+						h.idx.Search(ctx, interfaceID, 10).Interfaces,
+					)), nil
+			}
+
+			team := interfaces[subject].Team
+			teamValues := []complexity.Values{}
+			for idx, iface := range interfaces {
+				if team != "" && iface.Team == team {
+					teamValues = append(teamValues, values[idx])
+				}
+			}
+
+			return mcp.NewToolResultJSON[InterfaceComplexityBreakdown](InterfaceComplexityBreakdown{
+				InterfaceID:        interfaceID,
+				Team:               team,
+				TeamInterfaceCount: len(teamValues),
+				Breakdown:          complexity.Explain(profile, values[subject], values, teamValues),
+			})
+		},
+	}
+}
@@ -0,0 +1,107 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestExplainInterfaceComplexityTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListInterfaceMetrics(gomock.Any()).Return([]repo.Interface{
+		{InterfaceID: "interface1", Team: "team1", MethodCount: 2, ConsumerCount: intPointer(1), ConsumingTeamCount: intPointer(1)},
+		{InterfaceID: "interface2", Team: "team1", MethodCount: 10, ConsumerCount: intPointer(4), ConsumingTeamCount: intPointer(2)},
+		{InterfaceID: "interface3", Team: "team2", MethodCount: 1},
+	}, nil)
+	repository.EXPECT().GetInterfaceComplexityProfile(gomock.Any()).Return(complexity.DefaultConfig().InterfaceProfile, nil)
+
+	tool := NewMCPHandler(repository, nil).explainInterfaceComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("explain_interface_complexity", map[string]interface{}{
+		"interface_id": "interface2",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"interfaceID":"interface2","team":"team1","teamInterfaceCount":2`)
+	assert.Contains(t, textResult.Text, `"profile":"interface","score":70`)
+	assert.Contains(t, textResult.Text, `"metric":"method_count","value":10,"normalized":1,"weight":0.25,"contribution":25`)
+}
+
+func TestExplainInterfaceComplexityTool_NotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListInterfaceMetrics(gomock.Any()).Return([]repo.Interface{
+		{InterfaceID: "interface1", MethodCount: 2},
+	}, nil)
+	repository.EXPECT().GetInterfaceComplexityProfile(gomock.Any()).Return(complexity.DefaultConfig().InterfaceProfile, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "interface9", 10).Return(search.Result{Interfaces: []string{"interface1"}})
+
+	tool := NewMCPHandler(repository, idx).explainInterfaceComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("explain_interface_complexity", map[string]interface{}{
+		"interface_id": "interface9",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Interface with ID interface9 not found")
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "interface1")
+}
+
+func TestExplainInterfaceComplexityTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListInterfaceMetrics(gomock.Any()).Return(nil, errors.New("db error"))
+
+	tool := NewMCPHandler(repository, nil).explainInterfaceComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("explain_interface_complexity", map[string]interface{}{
+		"interface_id": "interface1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "error explaining complexity of interface interface1: error listing interface metrics: db error")
+}
+
+func TestExplainInterfaceComplexityTool_MissingInterfaceID(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil).explainInterfaceComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("explain_interface_complexity", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Missing interface_id")
+}
@@ -0,0 +1,77 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/samber/lo"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+// NewExplainModuleComplexityTool returns the MCP tool definition and its handler for explaining the complexity score of a module.
+func (h *mcpHandler) explainModuleComplexityTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"explain_module_complexity",
+			mcp.WithDescription("Explains the complexity score of a module: the contribution of each metric of the scoring profile, "+
+				"the percentile of the score and of each metric across all modules, and the median score and metric values of the modules of the same team."),
+			mcp.WithString("module_id", mcp.Required(), mcp.Description("The ID of the module to explain the complexity score of")),
+			mcp.WithString("profile", mcp.Description("Name of the complexity scoring profile, for example default, coupling or balanced. Uses the configured default profile when omitted.")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[repo.ComplexityBreakdown](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			moduleID, err := request.RequireString("module_id")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing module_id",
+						"module_id",
+						"Use a valid module identifier")), nil
+			}
+			profile := request.GetString("profile", "")
+
+			// call business logic
+			if profile != "" {
+				profiles, err := h.repo.ListComplexityProfiles(ctx)
+				if err != nil {
+					return mcp.NewToolResultError(
+						resp.InternalError(ctx,
+							fmt.Sprintf("error listing complexity profiles: %s", err))), nil
+				}
+				names := lo.Map(profiles, func(p complexity.Profile, _ int) string { return p.Name })
+				if !lo.Contains(names, profile) {
+					return mcp.NewToolResultError(
+						resp.NotFound(ctx,
+							fmt.Sprintf("Complexity profile %s not found", profile),
+							"profile",
+							names,
+						)), nil
+				}
+			}
+
+			breakdown, exists, err := h.repo.GetComplexityBreakdown(ctx, moduleID, profile)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error explaining complexity of module %s: %s", moduleID, err))), nil
+			}
+			if !exists {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Module with ID %s not found", moduleID),
+						"module_id",
+						h.idx.Search(ctx, moduleID, 10).Modules,
+					)), nil
+			}
+
+			return mcp.NewToolResultJSON[repo.ComplexityBreakdown](breakdown)
+		},
+	}
+}
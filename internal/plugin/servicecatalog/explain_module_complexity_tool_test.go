@@ -0,0 +1,180 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestExplainModuleComplexityTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListComplexityProfiles(gomock.Any()).Return(complexity.DefaultConfig().Profiles, nil)
+	repository.EXPECT().GetComplexityBreakdown(gomock.Any(), "module1", "coupling").Return(repo.ComplexityBreakdown{
+		ModuleID:        "module1",
+		Team:            "team1",
+		TeamModuleCount: 3,
+		Breakdown: complexity.Breakdown{
+			Profile:         "coupling",
+			Score:           42,
+			Percentile:      95,
+			TeamMedianScore: 20,
+			Metrics: []complexity.MetricBreakdown{
+				{
+					Contribution: complexity.Contribution{Metric: complexity.FanIn, Value: 12, Normalized: 0.5, Weight: 0.3, Contribution: 15},
+					Percentile:   97,
+					TeamMedian:   4,
+				},
+			},
+		},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).explainModuleComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("explain_module_complexity", map[string]interface{}{
+		"module_id": "module1",
+		"profile":   "coupling",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"moduleID":"module1","team":"team1","teamModuleCount":3,"profile":"coupling","score":42,"percentile":95,"teamMedianScore":20`)
+	assert.Contains(t, textResult.Text, `{"metric":"fan_in","value":12,"normalized":0.5,"weight":0.3,"contribution":15,"percentile":97,"teamMedian":4}`)
+}
+
+func TestExplainModuleComplexityTool_DefaultProfile(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetComplexityBreakdown(gomock.Any(), "module1", "").Return(repo.ComplexityBreakdown{
+		ModuleID:  "module1",
+		Breakdown: complexity.Breakdown{Profile: "default"},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).explainModuleComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("explain_module_complexity", map[string]interface{}{
+		"module_id": "module1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"profile":"default"`)
+}
+
+func TestExplainModuleComplexityTool_ProfileNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListComplexityProfiles(gomock.Any()).Return(complexity.DefaultConfig().Profiles, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).explainModuleComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("explain_module_complexity", map[string]interface{}{
+		"module_id": "module1",
+		"profile":   "unknown",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Complexity profile unknown not found")
+	assert.Contains(t, textResult.Text, "balanced")
+}
+
+func TestExplainModuleComplexityTool_NotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetComplexityBreakdown(gomock.Any(), "nonexistent_module", "").Return(repo.ComplexityBreakdown{}, false, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_module", 10).Return(search.Result{Modules: []string{"suggested_module"}})
+
+	tool := NewMCPHandler(repository, idx).explainModuleComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("explain_module_complexity", map[string]interface{}{
+		"module_id": "nonexistent_module",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Module with ID nonexistent_module not found")
+	assert.Contains(t, textResult.Text, "suggested_module")
+}
+
+func TestExplainModuleComplexityTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetComplexityBreakdown(gomock.Any(), "module_with_error", "").Return(repo.ComplexityBreakdown{}, false, errors.New("failed to score"))
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).explainModuleComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("explain_module_complexity", map[string]interface{}{
+		"module_id": "module_with_error",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error explaining complexity of module module_with_error: failed to score")
+}
+
+func TestExplainModuleComplexityTool_MissingModuleID(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).explainModuleComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("explain_module_complexity", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Missing module_id")
+}
@@ -0,0 +1,30 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+)
+
+// renderDOT renders the graph as a Graphviz digraph where edges point from consumer to provider.
+func renderDOT(g Graph) string {
+	sb := strings.Builder{}
+	fmt.Fprintf(&sb, "digraph %s {\n", quoteDOT(g.Title))
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box];\n")
+	for _, node := range g.Nodes {
+		if node.Highlighted {
+			fmt.Fprintf(&sb, "  %s [style=filled, fillcolor=lightblue];\n", quoteDOT(node.ModuleID))
+			continue
+		}
+		fmt.Fprintf(&sb, "  %s;\n", quoteDOT(node.ModuleID))
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&sb, "  %s -> %s [label=%s];\n", quoteDOT(edge.ConsumerModuleID), quoteDOT(edge.ProviderModuleID), quoteDOT(edge.InterfaceID))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+func quoteDOT(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
@@ -0,0 +1,84 @@
+package export
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+// Format is a textual graph format a subgraph of the catalog can be rendered in.
+type Format string
+
+const (
+	// FormatDOT renders the graph in the Graphviz DOT language
+	FormatDOT Format = "dot"
+	// FormatMermaid renders the graph as a Mermaid flowchart
+	FormatMermaid Format = "mermaid"
+	// FormatGraphML renders the graph as GraphML XML
+	FormatGraphML Format = "graphml"
+)
+
+// Formats lists all supported formats.
+var Formats = []Format{FormatDOT, FormatMermaid, FormatGraphML}
+
+// Graph is a subgraph of the catalog: modules connected by the interfaces they consume from each other.
+type Graph struct {
+	Title string
+	Nodes []Node
+	Edges []repo.DependencyEdge
+}
+
+// Node is a module in a graph. Highlighted nodes are rendered distinctively, for example the root of a neighbourhood.
+type Node struct {
+	ModuleID    string
+	Highlighted bool
+}
+
+// NewGraph creates a graph of the given modules and edges. Modules only mentioned in edges are added as nodes.
+func NewGraph(title string, moduleIDs []string, edges []repo.DependencyEdge, highlighted ...string) Graph {
+	highlights := map[string]bool{}
+	for _, moduleID := range highlighted {
+		highlights[moduleID] = true
+	}
+
+	seen := map[string]bool{}
+	nodes := []Node{}
+	add := func(moduleID string) {
+		if seen[moduleID] {
+			return
+		}
+		seen[moduleID] = true
+		nodes = append(nodes, Node{ModuleID: moduleID, Highlighted: highlights[moduleID]})
+	}
+	for _, moduleID := range moduleIDs {
+		add(moduleID)
+	}
+	for _, edge := range edges {
+		add(edge.ConsumerModuleID)
+		add(edge.ProviderModuleID)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ModuleID < nodes[j].ModuleID
+	})
+
+	return Graph{
+		Title: title,
+		Nodes: nodes,
+		Edges: edges,
+	}
+}
+
+// Render renders the graph in the given format.
+func Render(g Graph, format Format) (string, error) {
+	switch format {
+	case FormatDOT:
+		return renderDOT(g), nil
+	case FormatMermaid:
+		return renderMermaid(g), nil
+	case FormatGraphML:
+		return renderGraphML(g)
+	default:
+		return "", fmt.Errorf("unsupported format %s", format)
+	}
+}
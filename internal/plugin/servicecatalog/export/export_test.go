@@ -0,0 +1,80 @@
+package export
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+func testGraph() Graph {
+	return NewGraph("neighbourhood of b", []string{"b", "d"}, []repo.DependencyEdge{
+		{ConsumerModuleID: "a", InterfaceID: "IB", ProviderModuleID: "b"},
+		{ConsumerModuleID: "b", InterfaceID: "I\"C", ProviderModuleID: "c-1"},
+	}, "b")
+}
+
+func TestNewGraph(t *testing.T) {
+	assert.Equal(t, []Node{
+		{ModuleID: "a"},
+		{ModuleID: "b", Highlighted: true},
+		{ModuleID: "c-1"},
+		{ModuleID: "d"},
+	}, testGraph().Nodes)
+}
+
+func TestRenderDOT(t *testing.T) {
+	rendered, err := Render(testGraph(), FormatDOT)
+	assert.NoError(t, err)
+	assert.Equal(t, `digraph "neighbourhood of b" {
+  rankdir=LR;
+  node [shape=box];
+  "a";
+  "b" [style=filled, fillcolor=lightblue];
+  "c-1";
+  "d";
+  "a" -> "b" [label="IB"];
+  "b" -> "c-1" [label="I\"C"];
+}
+`, rendered)
+}
+
+func TestRenderMermaid(t *testing.T) {
+	rendered, err := Render(testGraph(), FormatMermaid)
+	assert.NoError(t, err)
+	assert.Equal(t, `---
+title: "neighbourhood of b"
+---
+flowchart LR
+  n0["a"]
+  n1["b"]
+  n2["c-1"]
+  n3["d"]
+  n0 -->|"IB"| n1
+  n1 -->|"I#quot;C"| n2
+  classDef highlighted fill:#add8e6,stroke:#333
+  class n1 highlighted
+`, rendered)
+}
+
+func TestRenderGraphML(t *testing.T) {
+	rendered, err := Render(testGraph(), FormatGraphML)
+	assert.NoError(t, err)
+	assert.Contains(t, rendered, `<key id="title" for="graph" attr.name="title" attr.type="string"></key>`)
+	assert.Contains(t, rendered, `<graph id="G" edgedefault="directed">`)
+	assert.Contains(t, rendered, `<data key="title">neighbourhood of b</data>`)
+	assert.Contains(t, rendered, `<edge id="e1" source="b" target="c-1">`)
+	assert.Contains(t, rendered, `<data key="interface">I&#34;C</data>`)
+
+	doc := graphMLDocument{}
+	assert.NoError(t, xml.Unmarshal([]byte(rendered), &doc))
+	assert.Len(t, doc.Graph.Nodes, 4)
+	assert.Len(t, doc.Graph.Edges, 2)
+}
+
+func TestRenderUnsupported(t *testing.T) {
+	_, err := Render(testGraph(), Format("svg"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,85 @@
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Data        []graphMLData `xml:"data"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	ID     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// renderGraphML renders the graph as a directed GraphML document where edges point from consumer to provider.
+// The title is free text, so it is stored as data of the graph rather than as its ID.
+func renderGraphML(g Graph) (string, error) {
+	doc := graphMLDocument{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "title", For: "graph", AttrName: "title", AttrType: "string"},
+			{ID: "highlighted", For: "node", AttrName: "highlighted", AttrType: "boolean"},
+			{ID: "interface", For: "edge", AttrName: "interface", AttrType: "string"},
+		},
+		Graph: graphMLGraph{
+			ID:          "G",
+			EdgeDefault: "directed",
+			Data:        []graphMLData{{Key: "title", Value: g.Title}},
+			Nodes:       []graphMLNode{},
+			Edges:       []graphMLEdge{},
+		},
+	}
+	for _, node := range g.Nodes {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			ID:   node.ModuleID,
+			Data: []graphMLData{{Key: "highlighted", Value: fmt.Sprintf("%t", node.Highlighted)}},
+		})
+	}
+	for i, edge := range g.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			ID:     fmt.Sprintf("e%d", i),
+			Source: edge.ConsumerModuleID,
+			Target: edge.ProviderModuleID,
+			Data:   []graphMLData{{Key: "interface", Value: edge.InterfaceID}},
+		})
+	}
+
+	asXML, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("error marshalling graphml: %w", err)
+	}
+	return xml.Header + string(asXML) + "\n", nil
+}
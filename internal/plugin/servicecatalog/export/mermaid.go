@@ -0,0 +1,42 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+)
+
+// renderMermaid renders the graph as a Mermaid flowchart where edges point from consumer to provider.
+// Module IDs may contain characters Mermaid does not accept in identifiers, so nodes get generated identifiers and the module ID as label.
+func renderMermaid(g Graph) string {
+	identifiers := map[string]string{}
+	for i, node := range g.Nodes {
+		identifiers[node.ModuleID] = fmt.Sprintf("n%d", i)
+	}
+
+	sb := strings.Builder{}
+	if g.Title != "" {
+		fmt.Fprintf(&sb, "---\ntitle: %s\n---\n", quoteMermaid(g.Title))
+	}
+	sb.WriteString("flowchart LR\n")
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&sb, "  %s[%s]\n", identifiers[node.ModuleID], quoteMermaid(node.ModuleID))
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&sb, "  %s -->|%s| %s\n", identifiers[edge.ConsumerModuleID], quoteMermaid(edge.InterfaceID), identifiers[edge.ProviderModuleID])
+	}
+	highlighted := []string{}
+	for _, node := range g.Nodes {
+		if node.Highlighted {
+			highlighted = append(highlighted, identifiers[node.ModuleID])
+		}
+	}
+	if len(highlighted) > 0 {
+		sb.WriteString("  classDef highlighted fill:#add8e6,stroke:#333\n")
+		fmt.Fprintf(&sb, "  class %s highlighted\n", strings.Join(highlighted, ","))
+	}
+	return sb.String()
+}
+
+func quoteMermaid(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, "#quot;") + `"`
+}
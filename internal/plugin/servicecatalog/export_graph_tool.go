@@ -0,0 +1,160 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/samber/lo"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/export"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+// NewExportGraphTool returns the MCP tool definition and its handler for rendering a module subgraph as DOT, Mermaid or GraphML.
+func (h *mcpHandler) exportGraphTool() server.ServerTool {
+	formats := lo.Map(export.Formats, func(f export.Format, _ int) string { return string(f) })
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"export_graph",
+			mcp.WithDescription("Renders a module subgraph as Graphviz DOT, Mermaid flowchart or GraphML text, ready to paste into a design document. "+
+				"The subgraph is the neighbourhood of a module (module_id), the participants of a flow (flow_id) or the modules of a team (team_id). "+
+				"Edges point from the consuming module to the module exposing the interface and are labelled with the interface. Provide exactly one of module_id, flow_id or team_id."),
+			mcp.WithString("format", mcp.Enum(formats...),
+				mcp.DefaultString(string(export.FormatMermaid)),
+				mcp.Description("The format to render: dot, mermaid or graphml")),
+			mcp.WithString("module_id", mcp.Description("The ID of the module to export the neighbourhood of")),
+			mcp.WithString("flow_id", mcp.Description("The ID of the flow to export the participants of")),
+			mcp.WithString("team_id", mcp.Description("The ID of the team to export the modules of")),
+			mcp.WithString("direction", mcp.Enum(string(repo.DirectionDependencies), string(repo.DirectionConsumers), string(repo.DirectionBoth)),
+				mcp.DefaultString(string(repo.DirectionBoth)),
+				mcp.Description("For a module neighbourhood: the direction to traverse, dependencies, consumers or both")),
+			mcp.WithNumber("depth", mcp.Description(fmt.Sprintf("For a module neighbourhood: maximum number of hops to traverse (default %d, max %d)", defaultDependencyGraphDepth, maxDependencyGraphDepth))),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			format := export.Format(strings.ToLower(request.GetString("format", string(export.FormatMermaid))))
+			if !lo.Contains(export.Formats, format) {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid format %s", format),
+						"format",
+						"Use one of dot, mermaid or graphml")), nil
+			}
+			moduleID := request.GetString("module_id", "")
+			flowID := request.GetString("flow_id", "")
+			teamID := request.GetString("team_id", "")
+			if len(lo.Compact([]string{moduleID, flowID, teamID})) != 1 {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Provide exactly one of module_id, flow_id or team_id",
+						"module_id",
+						"Use a valid module, flow or team identifier")), nil
+			}
+			direction := repo.Direction(request.GetString("direction", string(repo.DirectionBoth)))
+			if direction != repo.DirectionDependencies && direction != repo.DirectionConsumers && direction != repo.DirectionBoth {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid direction %s", direction),
+						"direction",
+						"Use one of dependencies, consumers or both")), nil
+			}
+			depth := min(max(request.GetInt("depth", defaultDependencyGraphDepth), 1), maxDependencyGraphDepth)
+
+			// call business logic
+			var graph export.Graph
+			var errorResult *mcp.CallToolResult
+			switch {
+			case moduleID != "":
+				graph, errorResult = h.moduleNeighbourhoodGraph(ctx, moduleID, direction, depth)
+			case flowID != "":
+				graph, errorResult = h.flowGraph(ctx, flowID)
+			default:
+				graph, errorResult = h.teamGraph(ctx, teamID)
+			}
+			if errorResult != nil {
+				return errorResult, nil
+			}
+
+			rendered, err := export.Render(graph, format)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error rendering %s: %s", graph.Title, err))), nil
+			}
+
+			return mcp.NewToolResultText(rendered), nil
+		},
+	}
+}
+
+func (h *mcpHandler) moduleNeighbourhoodGraph(ctx context.Context, moduleID string, direction repo.Direction, depth int) (export.Graph, *mcp.CallToolResult) {
+	dependencyGraph, exists, err := h.repo.GetDependencyGraph(ctx, moduleID, direction, depth)
+	if err != nil {
+		return export.Graph{}, mcp.NewToolResultError(
+			resp.InternalError(ctx,
+				fmt.Sprintf("error getting dependency graph of module %s: %s", moduleID, err)))
+	}
+	if !exists {
+		return export.Graph{}, mcp.NewToolResultError(
+			resp.NotFound(ctx,
+				fmt.Sprintf("Module with ID %s not found", moduleID),
+				"module_id",
+				h.idx.Search(ctx, moduleID, 10).Modules,
+			))
+	}
+
+	moduleIDs := lo.Map(dependencyGraph.Nodes, func(n repo.DependencyNode, _ int) string { return n.ModuleID })
+	return export.NewGraph(fmt.Sprintf("module %s", moduleID), moduleIDs, dependencyGraph.Edges, moduleID), nil
+}
+
+func (h *mcpHandler) flowGraph(ctx context.Context, flowID string) (export.Graph, *mcp.CallToolResult) {
+	moduleIDs, exists, err := h.repo.ListParticpantsOfFlow(ctx, flowID)
+	if err != nil {
+		return export.Graph{}, mcp.NewToolResultError(
+			resp.InternalError(ctx,
+				fmt.Sprintf("error listing participants of flow %s: %s", flowID, err)))
+	}
+	if !exists {
+		return export.Graph{}, mcp.NewToolResultError(
+			resp.NotFound(ctx,
+				fmt.Sprintf("Flow with ID %s not found", flowID),
+				"flow_id",
+				h.idx.Search(ctx, flowID, 10).Flows,
+			))
+	}
+
+	return h.graphBetween(ctx, fmt.Sprintf("flow %s", flowID), moduleIDs)
+}
+
+func (h *mcpHandler) teamGraph(ctx context.Context, teamID string) (export.Graph, *mcp.CallToolResult) {
+	moduleIDs, exists, err := h.repo.ListModulesOfTeam(ctx, teamID)
+	if err != nil {
+		return export.Graph{}, mcp.NewToolResultError(
+			resp.InternalError(ctx,
+				fmt.Sprintf("error listing modules of team %s: %s", teamID, err)))
+	}
+	if !exists {
+		return export.Graph{}, mcp.NewToolResultError(
+			resp.NotFound(ctx,
+				fmt.Sprintf("Team with ID %s not found", teamID),
+				"team_id",
+				h.idx.Search(ctx, teamID, 10).Teams,
+			))
+	}
+
+	return h.graphBetween(ctx, fmt.Sprintf("team %s", teamID), moduleIDs)
+}
+
+func (h *mcpHandler) graphBetween(ctx context.Context, title string, moduleIDs []string) (export.Graph, *mcp.CallToolResult) {
+	edges, err := h.repo.ListDependencyEdgesBetween(ctx, moduleIDs)
+	if err != nil {
+		return export.Graph{}, mcp.NewToolResultError(
+			resp.InternalError(ctx,
+				fmt.Sprintf("error listing dependencies of %s: %s", title, err)))
+	}
+
+	return export.NewGraph(title, moduleIDs, edges), nil
+}
@@ -0,0 +1,185 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestExportGraphTool_ModuleAsMermaid(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetDependencyGraph(gomock.Any(), "module1", repo.DirectionConsumers, 1).Return(repo.DependencyGraph{
+		RootModuleID: "module1",
+		Nodes: []repo.DependencyNode{
+			{ModuleID: "module1", Direction: repo.DirectionRoot, Distance: 0},
+			{ModuleID: "module2", Direction: repo.DirectionConsumers, Distance: 1},
+		},
+		Edges: []repo.DependencyEdge{
+			{ConsumerModuleID: "module2", InterfaceID: "interface1", ProviderModuleID: "module1"},
+		},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).exportGraphTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("export_graph", map[string]interface{}{
+		"module_id": "module1",
+		"direction": "consumers",
+		"depth":     1,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "flowchart LR")
+	assert.Contains(t, textResult.Text, `n1 -->|"interface1"| n0`)
+	assert.Contains(t, textResult.Text, "class n0 highlighted")
+}
+
+func TestExportGraphTool_FlowAsDOT(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListParticpantsOfFlow(gomock.Any(), "flow1").Return([]string{"module1", "module2"}, true, nil)
+	repository.EXPECT().ListDependencyEdgesBetween(gomock.Any(), []string{"module1", "module2"}).Return([]repo.DependencyEdge{
+		{ConsumerModuleID: "module1", InterfaceID: "interface2", ProviderModuleID: "module2"},
+	}, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).exportGraphTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("export_graph", map[string]interface{}{
+		"flow_id": "flow1",
+		"format":  "dot",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `digraph "flow flow1" {`)
+	assert.Contains(t, textResult.Text, `"module1" -> "module2" [label="interface2"];`)
+}
+
+func TestExportGraphTool_TeamAsGraphML(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListModulesOfTeam(gomock.Any(), "team1").Return([]string{"module1"}, true, nil)
+	repository.EXPECT().ListDependencyEdgesBetween(gomock.Any(), []string{"module1"}).Return([]repo.DependencyEdge{}, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).exportGraphTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("export_graph", map[string]interface{}{
+		"team_id": "team1",
+		"format":  "graphml",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `<data key="title">team team1</data>`)
+	assert.Contains(t, textResult.Text, `<node id="module1">`)
+}
+
+func TestExportGraphTool_NotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListParticpantsOfFlow(gomock.Any(), "nonexistent_flow").Return(nil, false, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_flow", 10).Return(search.Result{Flows: []string{"suggested_flow"}})
+
+	tool := NewMCPHandler(repository, idx).exportGraphTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("export_graph", map[string]interface{}{
+		"flow_id": "nonexistent_flow",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Flow with ID nonexistent_flow not found")
+	assert.Contains(t, textResult.Text, "suggested_flow")
+}
+
+func TestExportGraphTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListModulesOfTeam(gomock.Any(), "team1").Return([]string{"module1"}, true, nil)
+	repository.EXPECT().ListDependencyEdgesBetween(gomock.Any(), []string{"module1"}).Return(nil, errors.New("failed to list"))
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).exportGraphTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("export_graph", map[string]interface{}{
+		"team_id": "team1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error listing dependencies of team team1: failed to list")
+}
+
+func TestExportGraphTool_InvalidInput(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).exportGraphTool()
+
+	for _, tc := range []struct {
+		args    map[string]interface{}
+		message string
+	}{
+		{args: nil, message: "Provide exactly one of module_id, flow_id or team_id"},
+		{args: map[string]interface{}{"module_id": "module1", "team_id": "team1"}, message: "Provide exactly one of module_id, flow_id or team_id"},
+		{args: map[string]interface{}{"module_id": "module1", "format": "svg"}, message: "Invalid format svg"},
+	} {
+		// When
+		result, err := tool.Handler(context.Background(), createRequest("export_graph", tc.args))
+
+		// Then
+		assert.NoError(t, err)
+		expectError(t, result, `"status": "invalid_input"`)
+		textResult := result.Content[0].(mcp.TextContent)
+		assert.Contains(t, textResult.Text, tc.message)
+	}
+}
@@ -0,0 +1,87 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+// NewFindDependencyPathTool returns the MCP tool definition and its handler for finding the shortest paths between modules.
+func (h *mcpHandler) findDependencyPathTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"find_dependency_path",
+			mcp.WithDescription("Finds the shortest chain(s) of consumed interfaces through which a module reaches another module (module A -> interface -> module B -> ...)."),
+			mcp.WithString("from_module_id", mcp.Required(), mcp.Description("The ID of the module where the chain starts (the consumer)")),
+			mcp.WithString("to_module_id", mcp.Required(), mcp.Description("The ID of the module where the chain ends (the provider)")),
+			mcp.WithNumber("limit_to", mcp.Description("Maximum number of shortest paths to return.")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[DependencyPathList](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			fromModuleID, err := request.RequireString("from_module_id")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing from_module_id",
+						"from_module_id",
+						"Use a valid module identifier")), nil
+			}
+			toModuleID, err := request.RequireString("to_module_id")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing to_module_id",
+						"to_module_id",
+						"Use a valid module identifier")), nil
+			}
+			if fromModuleID == toModuleID {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "from_module_id and to_module_id must differ",
+						"to_module_id",
+						"Use two different module identifiers")), nil
+			}
+			limit := request.GetInt("limit_to", 5)
+			if limit < 1 {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid limit_to %d", limit),
+						"limit_to",
+						"Use a positive number")), nil
+			}
+
+			// call business logic
+			paths, err := h.repo.ListShortestPaths(ctx, fromModuleID, toModuleID, limit)
+			unknownModule := repo.UnknownModuleError{}
+			if errors.As(err, &unknownModule) {
+				// report the parameter that refers to the unknown module
+				field := "to_module_id"
+				if unknownModule.ModuleID == fromModuleID {
+					field = "from_module_id"
+				}
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Module with ID %s not found", unknownModule.ModuleID),
+						field,
+						h.idx.Search(ctx, unknownModule.ModuleID, 10).Modules,
+					)), nil
+			}
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error finding paths from module %s to module %s: %s", fromModuleID, toModuleID, err))), nil
+			}
+
+			return mcp.NewToolResultJSON[DependencyPathList](DependencyPathList{
+				FromModuleID: fromModuleID,
+				ToModuleID:   toModuleID,
+				Paths:        paths,
+			})
+		},
+	}
+}
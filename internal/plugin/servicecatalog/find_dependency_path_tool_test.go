@@ -0,0 +1,181 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestFindDependencyPathTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListShortestPaths(gomock.Any(), "module1", "module3", 5).Return([]repo.DependencyPath{
+		{
+			Length: 2,
+			Hops: []repo.DependencyEdge{
+				{ConsumerModuleID: "module1", InterfaceID: "interface2", ProviderModuleID: "module2"},
+				{ConsumerModuleID: "module2", InterfaceID: "interface3", ProviderModuleID: "module3"},
+			},
+		},
+	}, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).findDependencyPathTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("find_dependency_path", map[string]interface{}{
+		"from_module_id": "module1",
+		"to_module_id":   "module3",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"fromModuleID":"module1","toModuleID":"module3"`)
+	assert.Contains(t, textResult.Text, `{"consumerModuleID":"module2","interfaceID":"interface3","providerModuleID":"module3"}`)
+}
+
+func TestFindDependencyPathTool_NotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListShortestPaths(gomock.Any(), "module1", "nonexistent_module", 5).Return(nil, repo.UnknownModuleError{ModuleID: "nonexistent_module"})
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_module", 10).Return(search.Result{Modules: []string{"suggested_module"}})
+
+	tool := NewMCPHandler(repository, idx).findDependencyPathTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("find_dependency_path", map[string]interface{}{
+		"from_module_id": "module1",
+		"to_module_id":   "nonexistent_module",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Module with ID nonexistent_module not found")
+	assert.Contains(t, textResult.Text, `"to_module_id": [`)
+	assert.Contains(t, textResult.Text, "suggested_module")
+}
+
+func TestFindDependencyPathTool_FromNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListShortestPaths(gomock.Any(), "nonexistent_module", "module1", 5).Return(nil, repo.UnknownModuleError{ModuleID: "nonexistent_module"})
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_module", 10).Return(search.Result{Modules: []string{"suggested_module"}})
+
+	tool := NewMCPHandler(repository, idx).findDependencyPathTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("find_dependency_path", map[string]interface{}{
+		"from_module_id": "nonexistent_module",
+		"to_module_id":   "module1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Module with ID nonexistent_module not found")
+	assert.Contains(t, textResult.Text, `"from_module_id": [`)
+	assert.Contains(t, textResult.Text, "suggested_module")
+}
+
+func TestFindDependencyPathTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListShortestPaths(gomock.Any(), "module1", "module2", 5).Return(nil, errors.New("failed to search"))
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).findDependencyPathTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("find_dependency_path", map[string]interface{}{
+		"from_module_id": "module1",
+		"to_module_id":   "module2",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error finding paths from module module1 to module module2: failed to search")
+}
+
+func TestFindDependencyPathTool_InvalidInput(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).findDependencyPathTool()
+
+	testCases := []struct {
+		args     map[string]interface{}
+		expected string
+	}{
+		{args: nil, expected: "Missing from_module_id"},
+		{args: map[string]interface{}{"from_module_id": "module1"}, expected: "Missing to_module_id"},
+		{args: map[string]interface{}{"from_module_id": "module1", "to_module_id": "module1"}, expected: "from_module_id and to_module_id must differ"},
+	}
+	for _, tc := range testCases {
+		// When
+		result, err := tool.Handler(context.Background(), createRequest("find_dependency_path", tc.args))
+
+		// Then
+		assert.NoError(t, err)
+		expectError(t, result, `"status": "invalid_input"`)
+		textResult := result.Content[0].(mcp.TextContent)
+		assert.Contains(t, textResult.Text, tc.expected)
+	}
+}
+
+func TestFindDependencyPathTool_InvalidLimit(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+
+	tool := NewMCPHandler(repository, nil).findDependencyPathTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("find_dependency_path", map[string]interface{}{
+		"from_module_id": "module1",
+		"to_module_id":   "module2",
+		"limit_to":       -1,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Invalid limit_to -1")
+}
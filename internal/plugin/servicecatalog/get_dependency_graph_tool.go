@@ -0,0 +1,72 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+const (
+	defaultDependencyGraphDepth = 2
+	maxDependencyGraphDepth     = 6
+)
+
+// NewGetDependencyGraphTool returns the MCP tool definition and its handler for traversing module dependencies.
+func (h *mcpHandler) getDependencyGraphTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"get_dependency_graph",
+			mcp.WithDescription("Walks the module -> interface -> module graph starting at a module and returns the modules, interface edges and hop distance up to a given depth. "+
+				"Direction 'dependencies' follows the interfaces the module consumes, 'consumers' follows the modules that consume its interfaces (=what breaks if the module goes down)."),
+			mcp.WithString("module_id", mcp.Required(), mcp.Description("The ID of the module to start the traversal from")),
+			mcp.WithString("direction", mcp.Enum(string(repo.DirectionDependencies), string(repo.DirectionConsumers), string(repo.DirectionBoth)),
+				mcp.DefaultString(string(repo.DirectionBoth)),
+				mcp.Description("The direction to traverse: dependencies, consumers or both")),
+			mcp.WithNumber("depth", mcp.Description(fmt.Sprintf("Maximum number of hops to traverse (default %d, max %d)", defaultDependencyGraphDepth, maxDependencyGraphDepth))),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[repo.DependencyGraph](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			moduleID, err := request.RequireString("module_id")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing module_id",
+						"module_id",
+						"Use a valid module identifier")), nil
+			}
+			direction := repo.Direction(request.GetString("direction", string(repo.DirectionBoth)))
+			if direction != repo.DirectionDependencies && direction != repo.DirectionConsumers && direction != repo.DirectionBoth {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid direction %s", direction),
+						"direction",
+						"Use one of dependencies, consumers or both")), nil
+			}
+			depth := min(max(request.GetInt("depth", defaultDependencyGraphDepth), 1), maxDependencyGraphDepth)
+
+			// call business logic
+			graph, exists, err := h.repo.GetDependencyGraph(ctx, moduleID, direction, depth)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error getting dependency graph of module %s: %s", moduleID, err))), nil
+			}
+			if !exists {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Module with ID %s not found", moduleID),
+						"module_id",
+						h.idx.Search(ctx, moduleID, 10).Modules,
+					)), nil
+			}
+
+			return mcp.NewToolResultJSON[repo.DependencyGraph](graph)
+		},
+	}
+}
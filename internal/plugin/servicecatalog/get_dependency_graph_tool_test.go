@@ -0,0 +1,167 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestGetDependencyGraphTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetDependencyGraph(gomock.Any(), "module1", repo.DirectionConsumers, 3).Return(repo.DependencyGraph{
+		RootModuleID: "module1",
+		Direction:    repo.DirectionConsumers,
+		Depth:        3,
+		Nodes: []repo.DependencyNode{
+			{ModuleID: "module1", Direction: repo.DirectionRoot, Distance: 0},
+			{ModuleID: "module2", Direction: repo.DirectionConsumers, Distance: 1},
+		},
+		Edges: []repo.DependencyEdge{
+			{ConsumerModuleID: "module2", InterfaceID: "interface1", ProviderModuleID: "module1"},
+		},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getDependencyGraphTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_dependency_graph", map[string]interface{}{
+		"module_id": "module1",
+		"direction": "consumers",
+		"depth":     3,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `{"moduleID":"module2","direction":"consumers","distance":1}`)
+	assert.Contains(t, textResult.Text, `{"consumerModuleID":"module2","interfaceID":"interface1","providerModuleID":"module1"}`)
+}
+
+func TestGetDependencyGraphTool_DepthLimited(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetDependencyGraph(gomock.Any(), "module1", repo.DirectionBoth, maxDependencyGraphDepth).Return(repo.DependencyGraph{RootModuleID: "module1"}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getDependencyGraphTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_dependency_graph", map[string]interface{}{
+		"module_id": "module1",
+		"depth":     100,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+}
+
+func TestGetDependencyGraphTool_InvalidDirection(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getDependencyGraphTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_dependency_graph", map[string]interface{}{
+		"module_id": "module1",
+		"direction": "sideways",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Invalid direction sideways")
+}
+
+func TestGetDependencyGraphTool_NotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetDependencyGraph(gomock.Any(), "nonexistent_module", repo.DirectionBoth, defaultDependencyGraphDepth).Return(repo.DependencyGraph{}, false, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_module", 10).Return(search.Result{Modules: []string{"suggested_module"}})
+
+	tool := NewMCPHandler(repository, idx).getDependencyGraphTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_dependency_graph", map[string]interface{}{
+		"module_id": "nonexistent_module",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Module with ID nonexistent_module not found")
+	assert.Contains(t, textResult.Text, "suggested_module")
+}
+
+func TestGetDependencyGraphTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetDependencyGraph(gomock.Any(), "module_with_error", repo.DirectionBoth, defaultDependencyGraphDepth).Return(repo.DependencyGraph{}, false, errors.New("failed to traverse"))
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getDependencyGraphTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_dependency_graph", map[string]interface{}{
+		"module_id": "module_with_error",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error getting dependency graph of module module_with_error: failed to traverse")
+}
+
+func TestGetDependencyGraphTool_MissingModuleID(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getDependencyGraphTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_dependency_graph", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Missing module_id")
+}
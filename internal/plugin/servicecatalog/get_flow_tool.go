@@ -0,0 +1,54 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+// NewGetFlowTool returns the MCP tool definition and its handler for getting the topology of a flow.
+func (h *mcpHandler) getFlowTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"get_flow",
+			mcp.WithDescription("Returns a flow as a graph: the participating modules with their team, the interface edges between participants (consumer -> provider), "+
+				"the teams involved and the entry points, being the participants that no other participant consumes an interface of."),
+			mcp.WithString("flow_id", mcp.Required(), mcp.Description("The ID of the flow")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[repo.Flow](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			flowID, err := request.RequireString("flow_id")
+			if err != nil {
+				return mcp.NewToolResultError(resp.InvalidInput(ctx, "Missing flow_id",
+					"flow_id",
+					"Use a valid flow identifier")), nil
+			}
+
+			// call business logic
+			flow, exists, err := h.repo.GetFlowOnID(ctx, flowID)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error getting flow %s: %s", flowID, err))), nil
+			}
+			if !exists {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Flow with ID %s not found", flowID),
+						"flow_id",
+						h.idx.Search(ctx, flowID, 10).Flows,
+					)), nil
+			}
+
+			return mcp.NewToolResultJSON[repo.Flow](flow)
+		},
+	}
+}
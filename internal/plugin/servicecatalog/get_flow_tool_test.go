@@ -0,0 +1,120 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestGetFlowTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetFlowOnID(gomock.Any(), "flow1").Return(repo.Flow{
+		FlowID: "flow1",
+		Participants: []repo.FlowParticipant{
+			{ModuleID: "module1", Team: "team1"},
+			{ModuleID: "module2", Team: "team2"},
+		},
+		Edges: []repo.DependencyEdge{
+			{ConsumerModuleID: "module1", InterfaceID: "interface2", ProviderModuleID: "module2"},
+		},
+		Teams:       []string{"team1", "team2"},
+		EntryPoints: []string{"module1"},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getFlowTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_flow", map[string]interface{}{
+		"flow_id": "flow1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `{"moduleID":"module1","team":"team1"}`)
+	assert.Contains(t, textResult.Text, `{"consumerModuleID":"module1","interfaceID":"interface2","providerModuleID":"module2"}`)
+	assert.Contains(t, textResult.Text, `"teams":["team1","team2"],"entryPoints":["module1"]`)
+}
+
+func TestGetFlowTool_NotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetFlowOnID(gomock.Any(), "nonexistent_flow").Return(repo.Flow{}, false, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_flow", 10).Return(search.Result{Flows: []string{"suggested_flow"}})
+
+	tool := NewMCPHandler(repository, idx).getFlowTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_flow", map[string]interface{}{
+		"flow_id": "nonexistent_flow",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Flow with ID nonexistent_flow not found")
+	assert.Contains(t, textResult.Text, "suggested_flow")
+}
+
+func TestGetFlowTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetFlowOnID(gomock.Any(), "flow_with_error").Return(repo.Flow{}, false, errors.New("failed to get"))
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getFlowTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_flow", map[string]interface{}{
+		"flow_id": "flow_with_error",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error getting flow flow_with_error: failed to get")
+}
+
+func TestGetFlowTool_MissingFlowID(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getFlowTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_flow", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Missing flow_id")
+}
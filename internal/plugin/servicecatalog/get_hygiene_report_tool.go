@@ -0,0 +1,52 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+)
+
+// NewGetHygieneReportTool returns the MCP tool definition and its handler for finding unused catalog entities.
+func (h *mcpHandler) getHygieneReportTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"get_hygiene_report",
+			mcp.WithDescription("Reports catalog entities that are candidates for cleanup: "+
+				"interfaces (=web-api) that no module consumes, interfaces that no module exposes, "+
+				"modules whose interfaces are not consumed by any other module and databases that no module uses."),
+			mcp.WithNumber("limit_to", mcp.Description("Maximum number of identifiers to return per category.")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[HygieneReport](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			limit := request.GetInt("limit_to", 100)
+			if limit < 1 {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid limit_to %d", limit),
+						"limit_to",
+						"Use a positive number")), nil
+			}
+
+			// call business logic
+			report, err := h.repo.GetHygieneReport(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error getting hygiene report: %s", err))), nil
+			}
+
+			return mcp.NewToolResultJSON[HygieneReport](HygieneReport{
+				UnconsumedInterfaces:      newCleanupCandidateList(report.UnconsumedInterfaces, limit),
+				UnexposedInterfaces:       newCleanupCandidateList(report.UnexposedInterfaces, limit),
+				ModulesWithoutConsumers:   newCleanupCandidateList(report.ModulesWithoutConsumers, limit),
+				DatabasesWithoutConsumers: newCleanupCandidateList(report.DatabasesWithoutConsumers, limit),
+			})
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestGetHygieneReportTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetHygieneReport(gomock.Any()).Return(repo.HygieneReport{
+		UnconsumedInterfaces:      []string{"interface1", "interface2", "interface3"},
+		UnexposedInterfaces:       []string{"interface3"},
+		ModulesWithoutConsumers:   []string{"module1"},
+		DatabasesWithoutConsumers: []string{},
+	}, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getHygieneReportTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_hygiene_report", map[string]interface{}{
+		"limit_to": 2,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"unconsumedInterfaces":{"totalCount":3,"ids":["interface1","interface2"]}`)
+	assert.Contains(t, textResult.Text, `"unexposedInterfaces":{"totalCount":1,"ids":["interface3"]}`)
+	assert.Contains(t, textResult.Text, `"modulesWithoutConsumers":{"totalCount":1,"ids":["module1"]}`)
+	assert.Contains(t, textResult.Text, `"databasesWithoutConsumers":{"totalCount":0,"ids":[]}`)
+}
+
+func TestGetHygieneReportTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetHygieneReport(gomock.Any()).Return(repo.HygieneReport{}, errors.New("failed to report"))
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getHygieneReportTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_hygiene_report", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error getting hygiene report: failed to report")
+}
+
+func TestGetHygieneReportTool_InvalidLimit(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+
+	tool := NewMCPHandler(repository, nil).getHygieneReportTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_hygiene_report", map[string]interface{}{
+		"limit_to": -1,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Invalid limit_to -1")
+}
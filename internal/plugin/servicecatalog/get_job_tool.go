@@ -0,0 +1,54 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+// NewGetJobTool returns the MCP tool definition and its handler for getting a scheduled job.
+func (h *mcpHandler) getJobTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"get_job",
+			mcp.WithDescription("Gives the module(s) that run a scheduled (batch) job and the teams owning them"),
+			mcp.WithString("job_id", mcp.Required(), mcp.Description("The ID of the job to get details for")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[repo.Job](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			jobID, err := request.RequireString("job_id")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing job_id",
+						"job_id",
+						"Use a valid job identifier")), nil
+			}
+
+			// call business logic
+			job, exists, err := h.repo.GetJobOnID(ctx, jobID)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error getting job %s: %s", jobID, err))), nil
+			}
+			if !exists {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Job with ID %s not found", jobID),
+						"job_id",
+						h.idx.Search(ctx, jobID, 10).Jobs,
+					)), nil
+			}
+
+			return mcp.NewToolResultJSON[repo.Job](job)
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestGetJobTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetJobOnID(gomock.Any(), "job1").Return(repo.Job{
+		JobID:   "job1",
+		Modules: []string{"module1"},
+		Teams:   []string{"team1"},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getJobTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_job", map[string]interface{}{
+		"job_id": "job1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Equal(t, `{"jobID":"job1","modules":["module1"],"teams":["team1"]}`, textResult.Text)
+}
+
+func TestGetJobTool_NotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetJobOnID(gomock.Any(), "nonexistent_job").Return(repo.Job{}, false, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_job", 10).Return(search.Result{Jobs: []string{"suggested_job"}})
+
+	tool := NewMCPHandler(repository, idx).getJobTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_job", map[string]interface{}{
+		"job_id": "nonexistent_job",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Job with ID nonexistent_job not found")
+	assert.Contains(t, textResult.Text, "suggested_job")
+}
+
+func TestGetJobTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetJobOnID(gomock.Any(), "job_with_error").Return(repo.Job{}, false, errors.New("failed to get job"))
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getJobTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_job", map[string]interface{}{
+		"job_id": "job_with_error",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error getting job job_with_error: failed to get job")
+}
+
+func TestGetJobTool_MissingJobID(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getJobTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_job", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Missing job_id")
+}
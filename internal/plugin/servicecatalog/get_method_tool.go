@@ -0,0 +1,55 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+// NewGetMethodTool returns the MCP tool definition and its handler for resolving a web-method.
+func (h *mcpHandler) getMethodTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"get_method",
+			mcp.WithDescription("Resolves a web-method (as found in stack traces and access logs) to the interface(s) (=web-api) containing it, "+
+				"the module(s) exposing these interfaces and the modules consuming them."),
+			mcp.WithString("method_id", mcp.Required(), mcp.Description("The ID of the web-method to get details for")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[repo.Method](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			methodID, err := request.RequireString("method_id")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing method_id",
+						"method_id",
+						"Use a valid method identifier")), nil
+			}
+
+			// call business logic
+			method, exists, err := h.repo.GetMethodOnID(ctx, methodID)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error getting method %s: %s", methodID, err))), nil
+			}
+			if !exists {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Method with ID %s not found", methodID),
+						"method_id",
+						h.idx.Search(ctx, methodID, 10).Methods,
+					)), nil
+			}
+
+			return mcp.NewToolResultJSON[repo.Method](method)
+		},
+	}
+}
@@ -0,0 +1,112 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestGetMethodTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetMethodOnID(gomock.Any(), "method1").Return(repo.Method{
+		MethodID: "method1",
+		Interfaces: []repo.MethodInterface{
+			{InterfaceID: "interface1", ExposedBy: []string{"module1"}, ConsumedBy: []string{"module2", "module3"}},
+		},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getMethodTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_method", map[string]interface{}{
+		"method_id": "method1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `{"methodID":"method1","interfaces":[{"interfaceID":"interface1","exposedBy":["module1"],"consumedBy":["module2","module3"]}]}`)
+}
+
+func TestGetMethodTool_NotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetMethodOnID(gomock.Any(), "nonexistent_method").Return(repo.Method{}, false, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_method", 10).Return(search.Result{Methods: []string{"suggested_method"}})
+
+	tool := NewMCPHandler(repository, idx).getMethodTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_method", map[string]interface{}{
+		"method_id": "nonexistent_method",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Method with ID nonexistent_method not found")
+	assert.Contains(t, textResult.Text, "suggested_method")
+}
+
+func TestGetMethodTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetMethodOnID(gomock.Any(), "method_with_error").Return(repo.Method{}, false, errors.New("failed to get method"))
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getMethodTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_method", map[string]interface{}{
+		"method_id": "method_with_error",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error getting method method_with_error: failed to get method")
+}
+
+func TestGetMethodTool_MissingMethodID(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getMethodTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_method", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Missing method_id")
+}
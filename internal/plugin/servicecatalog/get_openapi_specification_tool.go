@@ -0,0 +1,148 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
+)
+
+// NewGetOpenAPISpecificationTool returns the MCP tool definition and its handler for browsing the OpenAPI specification of an interface.
+func (h *mcpHandler) getOpenAPISpecificationTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"get_openapi_specification",
+			mcp.WithDescription("Gives the OpenAPI specification of an interface (=web-api) as a list of operations "+
+				"with their method, path, request and response schema names and deprecated flag. "+
+				"Use operation_id to only get a single operation and include_raw to also get the complete specification document."),
+			mcp.WithString("interface_id", mcp.Required(), mcp.Description("The ID of the interface to get the OpenAPI specification for")),
+			mcp.WithString("operation_id", mcp.Description("Only return the operation with this operationId or \"METHOD /path\"")),
+			mcp.WithBoolean("include_raw", mcp.Description("Also return the complete specification document. Can be very large.")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[OpenAPISpecification](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			interfaceID, err := request.RequireString("interface_id")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing interface_id",
+						"interface_id",
+						"Use a valid interface identifier")), nil
+			}
+			operationID := request.GetString("operation_id", "")
+			includeRaw := request.GetBool("include_raw", false)
+
+			// call business logic
+			iface, exists, err := h.repo.GetInterfaceOnID(ctx, interfaceID)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error getting interface %s: %s", interfaceID, err))), nil
+			}
+			if !exists {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Interface with ID %s not found", interfaceID),
+						"interface_id",
+						h.idx.Search(ctx, interfaceID, 10).Interfaces,
+					)), nil
+			}
+
+			data, parsed, errorResult := loadOpenAPISpecification(ctx, h.specs, iface, "")
+			if errorResult != nil {
+				return errorResult, nil
+			}
+
+			operations := parsed.Operations
+			if operationID != "" {
+				operations = []spec.Operation{}
+				for _, operation := range parsed.Operations {
+					if operation.Matches(operationID) {
+						operations = append(operations, operation)
+					}
+				}
+				if len(operations) == 0 {
+					return mcp.NewToolResultError(
+						resp.NotFound(ctx,
+							fmt.Sprintf("Operation %s not found in interface %s", operationID, interfaceID),
+							"operation_id",
+							operationNames(parsed.Operations),
+						)), nil
+				}
+			}
+
+			specification := OpenAPISpecification{
+				InterfaceID:       interfaceID,
+				SpecificationPath: *iface.OpenAPISpecs,
+				Title:             parsed.Title,
+				Version:           parsed.Version,
+				Operations:        operations,
+			}
+			if includeRaw {
+				specification.Raw = string(data)
+			}
+
+			return mcp.NewToolResultJSON[OpenAPISpecification](specification)
+		},
+	}
+}
+
+func operationNames(operations []spec.Operation) []string {
+	names := []string{}
+	for _, operation := range operations {
+		if operation.OperationID != "" {
+			names = append(names, operation.OperationID)
+		} else {
+			names = append(names, operation.Key())
+		}
+	}
+	return names
+}
+
+// loadOpenAPISpecification reads and parses the OpenAPI specification of an interface. The catalog label is used in error messages.
+func loadOpenAPISpecification(ctx context.Context, loader spec.Loader, iface repo.Interface, catalog string) ([]byte, spec.OpenAPISpec, *mcp.CallToolResult) {
+	suffix := ""
+	if catalog != "" {
+		suffix = " in " + catalog + " catalog"
+	}
+
+	if iface.OpenAPISpecs == nil || *iface.OpenAPISpecs == "" {
+		return nil, spec.OpenAPISpec{}, mcp.NewToolResultError(
+			resp.NotFound(ctx,
+				fmt.Sprintf("Interface with ID %s has no OpenAPI specification%s", iface.InterfaceID, suffix),
+				"interface_id",
+				[]string{},
+			))
+	}
+
+	data, exists, err := loader.Load(ctx, *iface.OpenAPISpecs)
+	if err != nil {
+		return nil, spec.OpenAPISpec{}, mcp.NewToolResultError(
+			resp.InternalError(ctx,
+				fmt.Sprintf("error loading OpenAPI specification of interface %s%s: %s", iface.InterfaceID, suffix, err)))
+	}
+	if !exists {
+		return nil, spec.OpenAPISpec{}, mcp.NewToolResultError(
+			resp.NotFound(ctx,
+				fmt.Sprintf("OpenAPI specification %s of interface %s not found%s", *iface.OpenAPISpecs, iface.InterfaceID, suffix),
+				"interface_id",
+				[]string{},
+			))
+	}
+
+	parsed, err := spec.ParseOpenAPI(data)
+	if err != nil {
+		return nil, spec.OpenAPISpec{}, mcp.NewToolResultError(
+			resp.InternalError(ctx,
+				fmt.Sprintf("error parsing OpenAPI specification of interface %s%s: %s", iface.InterfaceID, suffix, err)))
+	}
+
+	return data, parsed, nil
+}
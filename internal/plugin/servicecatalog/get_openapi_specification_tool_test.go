@@ -0,0 +1,234 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
+)
+
+const openAPISpecification = `{
+	"openapi": "3.0.1",
+	"info": {"title": "Management API", "version": "3"},
+	"paths": {
+		"/companies": {
+			"get": {
+				"operationId": "listCompanies",
+				"deprecated": true,
+				"responses": {"200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/ListCompanyResponse"}}}}}
+			},
+			"post": {
+				"operationId": "createCompany",
+				"requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/CreateCompanyRequest"}}}}
+			}
+		}
+	}
+}`
+
+func TestGetOpenAPISpecificationTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID:  "interface1",
+		OpenAPISpecs: stringPointer("specs/interface1.json"),
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	loader := spec.NewMockLoader(ctrl)
+	loader.EXPECT().Load(gomock.Any(), "specs/interface1.json").Return([]byte(openAPISpecification), true, nil)
+
+	tool := NewMCPHandler(repository, idx, WithSpecLoader(loader)).getOpenAPISpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_openapi_specification", map[string]interface{}{
+		"interface_id": "interface1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"title":"Management API","version":"3"`)
+	assert.Contains(t, textResult.Text, `{"operationID":"listCompanies","method":"GET","path":"/companies","deprecated":true,"responseSchemas":{"200":"ListCompanyResponse"}}`)
+	assert.Contains(t, textResult.Text, `{"operationID":"createCompany","method":"POST","path":"/companies","requestSchemas":["CreateCompanyRequest"]}`)
+	assert.NotContains(t, textResult.Text, `"raw"`)
+}
+
+func TestGetOpenAPISpecificationTool_SingleOperationWithRaw(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID:  "interface1",
+		OpenAPISpecs: stringPointer("specs/interface1.json"),
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	loader := spec.NewMockLoader(ctrl)
+	loader.EXPECT().Load(gomock.Any(), "specs/interface1.json").Return([]byte(openAPISpecification), true, nil)
+
+	tool := NewMCPHandler(repository, idx, WithSpecLoader(loader)).getOpenAPISpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_openapi_specification", map[string]interface{}{
+		"interface_id": "interface1",
+		"operation_id": "POST /companies",
+		"include_raw":  true,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"operationID":"createCompany"`)
+	assert.NotContains(t, textResult.Text, `"operationID":"listCompanies"`)
+	assert.Contains(t, textResult.Text, `"raw":"{`)
+}
+
+func TestGetOpenAPISpecificationTool_OperationNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID:  "interface1",
+		OpenAPISpecs: stringPointer("specs/interface1.json"),
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	loader := spec.NewMockLoader(ctrl)
+	loader.EXPECT().Load(gomock.Any(), "specs/interface1.json").Return([]byte(openAPISpecification), true, nil)
+
+	tool := NewMCPHandler(repository, idx, WithSpecLoader(loader)).getOpenAPISpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_openapi_specification", map[string]interface{}{
+		"interface_id": "interface1",
+		"operation_id": "deleteCompany",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Operation deleteCompany not found in interface interface1")
+	assert.Contains(t, textResult.Text, "listCompanies")
+}
+
+func TestGetOpenAPISpecificationTool_NoSpecification(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID: "interface1",
+		RPLSpecs:    stringPointer("specs/rpl-interface1.xml"),
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	loader := spec.NewMockLoader(ctrl)
+
+	tool := NewMCPHandler(repository, idx, WithSpecLoader(loader)).getOpenAPISpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_openapi_specification", map[string]interface{}{
+		"interface_id": "interface1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Interface with ID interface1 has no OpenAPI specification")
+}
+
+func TestGetOpenAPISpecificationTool_NotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "nonexistent_interface").Return(repo.Interface{}, false, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_interface", 10).Return(search.Result{Interfaces: []string{"suggested_interface"}})
+
+	tool := NewMCPHandler(repository, idx).getOpenAPISpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_openapi_specification", map[string]interface{}{
+		"interface_id": "nonexistent_interface",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Interface with ID nonexistent_interface not found")
+	assert.Contains(t, textResult.Text, "suggested_interface")
+}
+
+func TestGetOpenAPISpecificationTool_LoadError(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID:  "interface1",
+		OpenAPISpecs: stringPointer("specs/interface1.json"),
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	loader := spec.NewMockLoader(ctrl)
+	loader.EXPECT().Load(gomock.Any(), "specs/interface1.json").Return(nil, false, errors.New("specification directory not configured"))
+
+	tool := NewMCPHandler(repository, idx, WithSpecLoader(loader)).getOpenAPISpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_openapi_specification", map[string]interface{}{
+		"interface_id": "interface1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error loading OpenAPI specification of interface interface1: specification directory not configured")
+}
+
+func TestGetOpenAPISpecificationTool_MissingInterfaceID(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getOpenAPISpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_openapi_specification", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Missing interface_id")
+}
@@ -0,0 +1,124 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
+)
+
+// NewGetRPLSpecificationTool returns the MCP tool definition and its handler for browsing the RPL specification of an interface.
+func (h *mcpHandler) getRPLSpecificationTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"get_rpl_specification",
+			mcp.WithDescription("Gives the RPL (=internal RPC) specification of an interface as a list of remote methods "+
+				"with their request and response message types, plus the fields of these message types. "+
+				"Use method_name to only get a single method with its messages and include_raw to also get the complete specification document. "+
+				"The parser assumes an XML format with service, method, request, response, message and field elements; "+
+				"when methods or fields are missing, use include_raw to read the specification itself."),
+			mcp.WithString("interface_id", mcp.Required(), mcp.Description("The ID of the interface to get the RPL specification for")),
+			mcp.WithString("method_name", mcp.Description("Only return this method and the message types it uses")),
+			mcp.WithBoolean("include_raw", mcp.Description("Also return the complete specification document. Can be very large.")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[RPLSpecification](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			interfaceID, err := request.RequireString("interface_id")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing interface_id",
+						"interface_id",
+						"Use a valid interface identifier")), nil
+			}
+			methodName := request.GetString("method_name", "")
+			includeRaw := request.GetBool("include_raw", false)
+
+			// call business logic
+			iface, exists, err := h.repo.GetInterfaceOnID(ctx, interfaceID)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error getting interface %s: %s", interfaceID, err))), nil
+			}
+			if !exists {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Interface with ID %s not found", interfaceID),
+						"interface_id",
+						h.idx.Search(ctx, interfaceID, 10).Interfaces,
+					)), nil
+			}
+			if iface.RPLSpecs == nil || *iface.RPLSpecs == "" {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Interface with ID %s has no RPL specification", interfaceID),
+						"interface_id",
+						[]string{},
+					)), nil
+			}
+
+			data, exists, err := h.specs.Load(ctx, *iface.RPLSpecs)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error loading RPL specification of interface %s: %s", interfaceID, err))), nil
+			}
+			if !exists {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("RPL specification %s of interface %s not found", *iface.RPLSpecs, interfaceID),
+						"interface_id",
+						[]string{},
+					)), nil
+			}
+
+			parsed, err := spec.ParseRPL(data)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error parsing RPL specification of interface %s: %s", interfaceID, err))), nil
+			}
+
+			specification := RPLSpecification{
+				InterfaceID:       interfaceID,
+				SpecificationPath: *iface.RPLSpecs,
+				Methods:           parsed.Methods,
+				Messages:          parsed.Messages,
+			}
+			if methodName != "" {
+				method, found := parsed.Method(methodName)
+				if !found {
+					names := []string{}
+					for _, m := range parsed.Methods {
+						names = append(names, m.Name)
+					}
+					return mcp.NewToolResultError(
+						resp.NotFound(ctx,
+							fmt.Sprintf("Method %s not found in interface %s", methodName, interfaceID),
+							"method_name",
+							names,
+						)), nil
+				}
+				specification.Methods = []spec.RPLMethod{method}
+				specification.Messages = []spec.RPLMessage{}
+				for _, messageName := range []string{method.Request, method.Response} {
+					if message, found := parsed.Message(messageName); found {
+						specification.Messages = append(specification.Messages, message)
+					}
+				}
+			}
+			if includeRaw {
+				specification.Raw = string(data)
+			}
+
+			return mcp.NewToolResultJSON[RPLSpecification](specification)
+		},
+	}
+}
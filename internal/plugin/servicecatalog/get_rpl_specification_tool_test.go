@@ -0,0 +1,204 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
+)
+
+const rplSpecification = `<rpl>
+	<service name="BinLookupService">
+		<method name="getCostEstimate" request="CostEstimateRequest" response="CostEstimateResponse"/>
+		<method name="get3dsAvailability" request="ThreeDSAvailabilityRequest" response="ThreeDSAvailabilityResponse"/>
+	</service>
+	<message name="CostEstimateRequest">
+		<field name="amount" type="Amount" required="true"/>
+	</message>
+	<message name="ThreeDSAvailabilityRequest">
+		<field name="brands" type="String" repeated="true"/>
+	</message>
+</rpl>`
+
+func TestGetRPLSpecificationTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID: "interface1",
+		RPLSpecs:    stringPointer("specs/rpl-interface1.xml"),
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	loader := spec.NewMockLoader(ctrl)
+	loader.EXPECT().Load(gomock.Any(), "specs/rpl-interface1.xml").Return([]byte(rplSpecification), true, nil)
+
+	tool := NewMCPHandler(repository, idx, WithSpecLoader(loader)).getRPLSpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_rpl_specification", map[string]interface{}{
+		"interface_id": "interface1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `{"name":"getCostEstimate","service":"BinLookupService","request":"CostEstimateRequest","response":"CostEstimateResponse"}`)
+	assert.Contains(t, textResult.Text, `{"name":"ThreeDSAvailabilityRequest","fields":[{"name":"brands","type":"String","repeated":true}]}`)
+	assert.NotContains(t, textResult.Text, `"raw"`)
+}
+
+func TestGetRPLSpecificationTool_SingleMethod(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID: "interface1",
+		RPLSpecs:    stringPointer("specs/rpl-interface1.xml"),
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	loader := spec.NewMockLoader(ctrl)
+	loader.EXPECT().Load(gomock.Any(), "specs/rpl-interface1.xml").Return([]byte(rplSpecification), true, nil)
+
+	tool := NewMCPHandler(repository, idx, WithSpecLoader(loader)).getRPLSpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_rpl_specification", map[string]interface{}{
+		"interface_id": "interface1",
+		"method_name":  "getCostEstimate",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"methods":[{"name":"getCostEstimate"`)
+	assert.Contains(t, textResult.Text, `"messages":[{"name":"CostEstimateRequest","fields":[{"name":"amount","type":"Amount","required":true}]}]`)
+	assert.NotContains(t, textResult.Text, "get3dsAvailability")
+}
+
+func TestGetRPLSpecificationTool_MethodNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID: "interface1",
+		RPLSpecs:    stringPointer("specs/rpl-interface1.xml"),
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	loader := spec.NewMockLoader(ctrl)
+	loader.EXPECT().Load(gomock.Any(), "specs/rpl-interface1.xml").Return([]byte(rplSpecification), true, nil)
+
+	tool := NewMCPHandler(repository, idx, WithSpecLoader(loader)).getRPLSpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_rpl_specification", map[string]interface{}{
+		"interface_id": "interface1",
+		"method_name":  "refund",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Method refund not found in interface interface1")
+	assert.Contains(t, textResult.Text, "getCostEstimate")
+}
+
+func TestGetRPLSpecificationTool_NoSpecification(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID:  "interface1",
+		OpenAPISpecs: stringPointer("specs/interface1.json"),
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getRPLSpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_rpl_specification", map[string]interface{}{
+		"interface_id": "interface1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Interface with ID interface1 has no RPL specification")
+}
+
+func TestGetRPLSpecificationTool_SpecificationNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface1").Return(repo.Interface{
+		InterfaceID: "interface1",
+		RPLSpecs:    stringPointer("specs/rpl-interface1.xml"),
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	loader := spec.NewMockLoader(ctrl)
+	loader.EXPECT().Load(gomock.Any(), "specs/rpl-interface1.xml").Return(nil, false, nil)
+
+	tool := NewMCPHandler(repository, idx, WithSpecLoader(loader)).getRPLSpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_rpl_specification", map[string]interface{}{
+		"interface_id": "interface1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "RPL specification specs/rpl-interface1.xml of interface interface1 not found")
+}
+
+func TestGetRPLSpecificationTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetInterfaceOnID(gomock.Any(), "interface_with_error").Return(repo.Interface{}, false, errors.New("failed to get interface"))
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getRPLSpecificationTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_rpl_specification", map[string]interface{}{
+		"interface_id": "interface_with_error",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error getting interface interface_with_error: failed to get interface")
+}
@@ -0,0 +1,55 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+// NewGetTeamTool returns the MCP tool definition and its handler for getting a team profile.
+func (h *mcpHandler) getTeamTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"get_team",
+			mcp.WithDescription("Gives an overview of a team: the modules it owns with their total lines of code and files, the summed and average complexity score, "+
+				"and the interfaces exposed and consumed, databases used, flows participated in and jobs run by these modules"),
+			mcp.WithString("team_id", mcp.Required(), mcp.Description("The ID of the team to get the overview for")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[repo.Team](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			teamID, err := request.RequireString("team_id")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing team_id",
+						"team_id",
+						"Use a valid team identifier")), nil
+			}
+
+			// call business logic
+			team, exists, err := h.repo.GetTeamOnID(ctx, teamID)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error getting team %s: %s", teamID, err))), nil
+			}
+			if !exists {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Team with ID %s not found", teamID),
+						"team_id",
+						h.idx.Search(ctx, teamID, 10).Teams,
+					)), nil
+			}
+
+			return mcp.NewToolResultJSON[repo.Team](team)
+		},
+	}
+}
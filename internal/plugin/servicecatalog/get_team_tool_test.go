@@ -0,0 +1,110 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestGetTeamTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetTeamOnID(gomock.Any(), "team1").Return(repo.Team{
+		TeamID:                 "team1",
+		Modules:                []string{"module1", "module2"},
+		ModuleCount:            2,
+		LineCount:              3000,
+		FileCount:              30,
+		TotalComplexityScore:   50,
+		AverageComplexityScore: 25,
+		ExposedInterfaces:      []string{"interface1"},
+		ConsumedInterfaces:     []string{"interface2"},
+		Databases:              []string{"database1"},
+		Flows:                  []string{"flow1"},
+		Jobs:                   []string{},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).getTeamTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_team", map[string]interface{}{
+		"team_id": "team1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Equal(t, `{"teamID":"team1","modules":["module1","module2"],"moduleCount":2,"lineCount":3000,"fileCount":30,"totalComplexityScore":50,"averageComplexityScore":25,"exposedInterfaces":["interface1"],"consumedInterfaces":["interface2"],"databases":["database1"],"flows":["flow1"],"jobs":[]}`, textResult.Text)
+}
+
+func TestGetTeamTool_NotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetTeamOnID(gomock.Any(), "nonexistent_team").Return(repo.Team{}, false, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_team", 10).Return(search.Result{Teams: []string{"suggested_team"}})
+
+	tool := NewMCPHandler(repository, idx).getTeamTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_team", map[string]interface{}{
+		"team_id": "nonexistent_team",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Team with ID nonexistent_team not found")
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "suggested_team")
+}
+
+func TestGetTeamTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetTeamOnID(gomock.Any(), "team1").Return(repo.Team{}, false, errors.New("db error"))
+
+	tool := NewMCPHandler(repository, nil).getTeamTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_team", map[string]interface{}{
+		"team_id": "team1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "error getting team team1: db error")
+}
+
+func TestGetTeamTool_MissingTeamID(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil).getTeamTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("get_team", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Missing team_id")
+}
@@ -0,0 +1,64 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
+)
+
+type openAPISize struct {
+	operations int
+	schemaSize int
+}
+
+// listInterfaceComplexity returns all interfaces with their complexity metrics, combining the counts known to the catalog
+// with the size of their OpenAPI specification, together with the profile to score them with.
+func (h *mcpHandler) listInterfaceComplexity(ctx context.Context) ([]repo.Interface, []complexity.Values, complexity.Profile, error) {
+	interfaces, err := h.repo.ListInterfaceMetrics(ctx)
+	if err != nil {
+		return nil, nil, complexity.Profile{}, fmt.Errorf("error listing interface metrics: %w", err)
+	}
+	profile, err := h.repo.GetInterfaceComplexityProfile(ctx)
+	if err != nil {
+		return nil, nil, complexity.Profile{}, fmt.Errorf("error getting interface complexity profile: %w", err)
+	}
+
+	values := []complexity.Values{}
+	for _, iface := range interfaces {
+		v := iface.ComplexityValues()
+		if iface.OpenAPISpecs != nil && *iface.OpenAPISpecs != "" {
+			size := h.openAPISize(ctx, *iface.OpenAPISpecs)
+			v[complexity.OperationCount] = float64(size.operations)
+			v[complexity.SchemaSize] = float64(size.schemaSize)
+		}
+		values = append(values, v)
+	}
+
+	return interfaces, values, profile, nil
+}
+
+// openAPISize returns the size of an OpenAPI specification, or zero when it can not be loaded or parsed.
+// Specifications do not change while running, so sizes are remembered per path.
+func (h *mcpHandler) openAPISize(ctx context.Context, path string) openAPISize {
+	if cached, found := h.openAPISizes.Load(path); found {
+		return cached.(openAPISize)
+	}
+
+	size := openAPISize{}
+	data, exists, err := h.specs.Load(ctx, path)
+	if err == nil && exists {
+		parsed, err := spec.ParseOpenAPI(data)
+		if err == nil {
+			size = openAPISize{
+				operations: len(parsed.Operations),
+				schemaSize: parsed.SchemaSize(),
+			}
+		}
+	}
+	h.openAPISizes.Store(path, size)
+
+	return size
+}
@@ -17,6 +17,7 @@ func (h *mcpHandler) listMDatabaseConsumersTool() server.ServerTool {
 			"list_database_consumers",
 			mcp.WithDescription("List all modules that consume a given database"),
 			mcp.WithString("database_id", mcp.Required(), mcp.Description("The ID of the database to list modules for")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[resp.List](),
@@ -31,6 +32,11 @@ func (h *mcpHandler) listMDatabaseConsumersTool() server.ServerTool {
 						"Use a valid database identifier")), nil
 			}
 
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+
 			// call business logic
 			moduleNames, exists, err := h.repo.ListDatabaseConsumers(ctx, databaseID)
 			if err != nil {
@@ -46,7 +52,7 @@ func (h *mcpHandler) listMDatabaseConsumersTool() server.ServerTool {
 					)), nil
 			}
 
-			return mcp.NewToolResultJSON[resp.List](resp.SliceToList(moduleNames))
+			return mcp.NewToolResultJSON[resp.List](resp.SliceToPagedList(moduleNames, page))
 		},
 	}
 }
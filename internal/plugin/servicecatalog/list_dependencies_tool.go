@@ -9,6 +9,7 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
 )
 
 func (h *mcpHandler) listDependenciesTool() server.ServerTool {
@@ -17,9 +18,11 @@ func (h *mcpHandler) listDependenciesTool() server.ServerTool {
 			"list_dependencies_of_module",
 			mcp.WithDescription("List all gradle dependencies of a module"),
 			mcp.WithString("module_id", mcp.Required(), mcp.Description("The ID of the module to list gradle dependencies for")),
+			mcp.WithBoolean("transitive", mcp.Description("Return the full transitive closure with the depth of every dependency and the diamond dependencies")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
-			mcp.WithOutputSchema[resp.List](),
+			mcp.WithOutputSchema[GradleModuleList](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			log.Info()
@@ -30,8 +33,30 @@ func (h *mcpHandler) listDependenciesTool() server.ServerTool {
 					"module_id",
 					"Use a valid module identifier")), nil
 			}
+			transitive := request.GetBool("transitive", false)
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
 
 			// call business logic
+			if transitive {
+				closure, exists, err := h.repo.GetGradleClosure(ctx, moduleID, repo.DirectionDependencies)
+				if err != nil {
+					return mcp.NewToolResultError(resp.InternalError(ctx,
+						fmt.Sprintf("error listing transitive gradle dependencies of module %s: %s", moduleID, err))), nil
+				}
+				if !exists {
+					return mcp.NewToolResultError(
+						resp.NotFound(ctx,
+							fmt.Sprintf("Module with ID %s not found", moduleID),
+							"module_id",
+							h.idx.Search(ctx, moduleID, 10).Modules)), nil
+				}
+
+				return mcp.NewToolResultJSON[GradleModuleList](closureToGradleModuleList(closure, page))
+			}
+
 			moduleNames, exists, err := h.repo.GetGradleDependenciesOfModule(ctx, moduleID)
 			if err != nil {
 				return mcp.NewToolResultError(resp.InternalError(ctx,
@@ -46,7 +71,8 @@ func (h *mcpHandler) listDependenciesTool() server.ServerTool {
 
 			}
 
-			return mcp.NewToolResultJSON[resp.List](resp.SliceToList(moduleNames))
+			moduleNames, nextCursor := resp.Paginate(moduleNames, page)
+			return mcp.NewToolResultJSON[GradleModuleList](GradleModuleList{Names: moduleNames, NextCursor: nextCursor})
 		},
 	}
 }
@@ -0,0 +1,145 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestListDependenciesTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetGradleDependenciesOfModule(gomock.Any(), "module1").Return([]string{"lib1", "lib2"}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).listDependenciesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_dependencies_of_module", map[string]interface{}{
+		"module_id": "module1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Equal(t, `{"names":["lib1","lib2"]}`, textResult.Text)
+}
+
+func TestListDependenciesTool_SuccessTransitive(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetGradleClosure(gomock.Any(), "module1", repo.DirectionDependencies).Return(repo.GradleClosure{
+		ModuleID:  "module1",
+		Direction: repo.DirectionDependencies,
+		Entries: []repo.GradleClosureEntry{
+			{ModuleID: "lib1", Depth: 1, Via: []string{"module1"}},
+			{ModuleID: "lib2", Depth: 1, Via: []string{"module1"}},
+			{ModuleID: "common", Depth: 2, Via: []string{"lib1", "lib2"}},
+		},
+		Diamonds: []repo.GradleClosureEntry{
+			{ModuleID: "common", Depth: 2, Via: []string{"lib1", "lib2"}},
+		},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).listDependenciesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_dependencies_of_module", map[string]interface{}{
+		"module_id":  "module1",
+		"transitive": true,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"names":["lib1","lib2","common"]`)
+	assert.Contains(t, textResult.Text, `"diamonds":[{"moduleID":"common","depth":2,"via":["lib1","lib2"]}]`)
+}
+
+func TestListDependenciesTool_NotFoundTransitive(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetGradleClosure(gomock.Any(), "nonexistent_module", repo.DirectionDependencies).Return(repo.GradleClosure{}, false, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_module", 10).Return(search.Result{Modules: []string{"suggested_module"}})
+
+	tool := NewMCPHandler(repository, idx).listDependenciesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_dependencies_of_module", map[string]interface{}{
+		"module_id":  "nonexistent_module",
+		"transitive": true,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Module with ID nonexistent_module not found")
+	assert.Contains(t, textResult.Text, "suggested_module")
+}
+
+func TestListDependenciesTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetGradleClosure(gomock.Any(), "module_with_error", repo.DirectionDependencies).Return(repo.GradleClosure{}, false, errors.New("failed to list dependencies"))
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).listDependenciesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_dependencies_of_module", map[string]interface{}{
+		"module_id":  "module_with_error",
+		"transitive": true,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error listing transitive gradle dependencies of module module_with_error: failed to list dependencies")
+}
+
+func TestListDependenciesTool_MissingModuleID(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).listDependenciesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_dependencies_of_module", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Missing module_id")
+}
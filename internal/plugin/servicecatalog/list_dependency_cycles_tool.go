@@ -0,0 +1,71 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+// NewListDependencyCyclesTool returns the MCP tool definition and its handler for listing circular module dependencies.
+func (h *mcpHandler) listDependencyCyclesTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"list_dependency_cycles",
+			mcp.WithDescription("Lists groups of modules that (indirectly) consume each other's interfaces (=circular dependencies), largest first, together with the interfaces that form each cycle."),
+			mcp.WithString("module_id", mcp.Description("Only return cycles this module is part of")),
+			mcp.WithNumber("limit_to", mcp.Description("Maximum number of cycles to return.")),
+			resp.WithPagination(),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[DependencyCycleList](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			moduleID := request.GetString("module_id", "")
+			limit := request.GetInt("limit_to", 20)
+			if limit < 1 {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid limit_to %d", limit),
+						"limit_to",
+						"Use a positive number")), nil
+			}
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+
+			// call business logic
+			cycles, err := h.repo.ListDependencyCycles(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error listing dependency cycles: %s", err))), nil
+			}
+
+			if moduleID != "" {
+				filtered := []repo.DependencyCycle{}
+				for _, cycle := range cycles {
+					if slices.Contains(cycle.Modules, moduleID) {
+						filtered = append(filtered, cycle)
+					}
+				}
+				cycles = filtered
+			}
+
+			// the total count describes the list that is paged: the cycles up to limit_to
+			cycles = cycles[0:min(limit, len(cycles))]
+			paged, nextCursor := resp.Paginate(cycles, page)
+			return mcp.NewToolResultJSON[DependencyCycleList](DependencyCycleList{
+				TotalCount: len(cycles),
+				Cycles:     paged,
+				NextCursor: nextCursor,
+			})
+		},
+	}
+}
@@ -0,0 +1,145 @@
+package servicecatalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+func testCycles() []repo.DependencyCycle {
+	return []repo.DependencyCycle{
+		{
+			Modules: []string{"module1", "module2", "module3"},
+			Edges: []repo.DependencyEdge{
+				{ConsumerModuleID: "module1", InterfaceID: "interface2", ProviderModuleID: "module2"},
+				{ConsumerModuleID: "module2", InterfaceID: "interface3", ProviderModuleID: "module3"},
+				{ConsumerModuleID: "module3", InterfaceID: "interface1", ProviderModuleID: "module1"},
+			},
+		},
+		{
+			Modules: []string{"module4", "module5"},
+			Edges: []repo.DependencyEdge{
+				{ConsumerModuleID: "module4", InterfaceID: "interface5", ProviderModuleID: "module5"},
+				{ConsumerModuleID: "module5", InterfaceID: "interface4", ProviderModuleID: "module4"},
+			},
+		},
+	}
+}
+
+func TestListDependencyCyclesTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListDependencyCycles(gomock.Any()).Return(testCycles(), nil)
+
+	tool := NewMCPHandler(repository, nil).listDependencyCyclesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_dependency_cycles", map[string]interface{}{
+		"limit_to": 1,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"totalCount":1`)
+	assert.Contains(t, textResult.Text, `"modules":["module1","module2","module3"]`)
+	assert.NotContains(t, textResult.Text, "module4")
+}
+
+func TestListDependencyCyclesTool_FilteredOnModule(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListDependencyCycles(gomock.Any()).Return(testCycles(), nil)
+
+	tool := NewMCPHandler(repository, nil).listDependencyCyclesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_dependency_cycles", map[string]interface{}{
+		"module_id": "module5",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"totalCount":1`)
+	assert.Contains(t, textResult.Text, `{"consumerModuleID":"module5","interfaceID":"interface4","providerModuleID":"module4"}`)
+	assert.NotContains(t, textResult.Text, "module1")
+}
+
+func TestListDependencyCyclesTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListDependencyCycles(gomock.Any()).Return(nil, errors.New("failed to list cycles"))
+
+	tool := NewMCPHandler(repository, nil).listDependencyCyclesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_dependency_cycles", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error listing dependency cycles: failed to list cycles")
+}
+
+func TestListDependencyCyclesTool_InvalidLimit(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+
+	tool := NewMCPHandler(repository, nil).listDependencyCyclesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_dependency_cycles", map[string]interface{}{
+		"limit_to": -1,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Invalid limit_to -1")
+}
+
+func TestListDependencyCyclesTool_Paged(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListDependencyCycles(gomock.Any()).Return(testCycles(), nil)
+
+	tool := NewMCPHandler(repository, nil).listDependencyCyclesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_dependency_cycles", map[string]interface{}{
+		"page_size": 1,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	page := DependencyCycleList{}
+	assert.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &page))
+	assert.Equal(t, 2, page.TotalCount)
+	assert.Len(t, page.Cycles, 1)
+	assert.NotEmpty(t, page.NextCursor)
+}
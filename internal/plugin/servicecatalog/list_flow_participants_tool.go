@@ -17,6 +17,7 @@ func (h *mcpHandler) listFlowParticipantsTool() server.ServerTool {
 			"list_flow_participants",
 			mcp.WithDescription("List all modules that that are participants of this flow"),
 			mcp.WithString("flow_id", mcp.Required(), mcp.Description("The ID of the flow")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[resp.List](),
@@ -30,6 +31,11 @@ func (h *mcpHandler) listFlowParticipantsTool() server.ServerTool {
 					"Use a valid flow identifier")), nil
 			}
 
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+
 			// call business logic
 			moduleNames, exists, err := h.repo.ListParticpantsOfFlow(ctx, flowID)
 			if err != nil {
@@ -47,7 +53,7 @@ func (h *mcpHandler) listFlowParticipantsTool() server.ServerTool {
 			}
 
 			// return result
-			return mcp.NewToolResultJSON[resp.List](resp.SliceToList(moduleNames))
+			return mcp.NewToolResultJSON[resp.List](resp.SliceToPagedList(moduleNames, page))
 		},
 	}
 }
@@ -16,11 +16,17 @@ func (h *mcpHandler) listFlowsTool() server.ServerTool {
 		Tool: mcp.NewTool(
 			"list_flows",
 			mcp.WithDescription("Lists all critical flows in the catalog."),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[resp.List](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
 
 			// call business logic
 			flows, err := h.repo.ListFlows(ctx)
@@ -30,7 +36,7 @@ func (h *mcpHandler) listFlowsTool() server.ServerTool {
 						fmt.Sprintf("error listing flows: %s", err))), nil
 			}
 
-			return mcp.NewToolResultJSON[resp.List](resp.SliceToList(flows))
+			return mcp.NewToolResultJSON[resp.List](resp.SliceToPagedList(flows, page))
 		},
 	}
 }
@@ -2,6 +2,7 @@ package servicecatalog
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
 )
 
@@ -33,6 +35,59 @@ func TestListFlowsTool_Success(t *testing.T) {
 	assert.Contains(t, textResult.Text, "flow2")
 }
 
+func TestListFlowsTool_Paged(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repo.NewMockCataloger(ctrl)
+	repo.EXPECT().ListFlows(gomock.Any()).Return([]string{"flow1", "flow2", "flow3"}, nil).Times(2)
+
+	tool := NewMCPHandler(repo, nil).listFlowsTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_flows", map[string]interface{}{
+		"page_size": 2,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	firstPage := resp.List{}
+	assert.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &firstPage))
+	assert.Equal(t, []string{"flow1", "flow2"}, firstPage.Names)
+	assert.NotEmpty(t, firstPage.NextCursor)
+
+	// When
+	result, err = tool.Handler(context.Background(), createRequest("list_flows", map[string]interface{}{
+		"page_size": 2,
+		"cursor":    firstPage.NextCursor,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	secondPage := resp.List{}
+	assert.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &secondPage))
+	assert.Equal(t, []string{"flow3"}, secondPage.Names)
+	assert.Empty(t, secondPage.NextCursor)
+}
+
+func TestListFlowsTool_InvalidCursor(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil).listFlowsTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_flows", map[string]interface{}{
+		"cursor": "garbage",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Invalid cursor garbage")
+}
+
 func TestListFlowsTool_Error(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
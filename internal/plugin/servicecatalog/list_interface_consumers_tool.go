@@ -17,6 +17,7 @@ func (h *mcpHandler) listInterfaceConsumersTool() server.ServerTool {
 			"list_interface_consumers",
 			mcp.WithDescription("List all modules that consume a given interface (=web-api)"),
 			mcp.WithString("interface_id", mcp.Required(), mcp.Description("The ID of the interface (=web-api) to list modules for")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[resp.List](),
@@ -30,6 +31,11 @@ func (h *mcpHandler) listInterfaceConsumersTool() server.ServerTool {
 					"Use a valid interface identifier")), nil
 			}
 
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+
 			// call business logic
 			moduleNames, exists, err := h.repo.ListInterfaceConsumers(ctx, interfaceID)
 			if err != nil {
@@ -47,7 +53,7 @@ func (h *mcpHandler) listInterfaceConsumersTool() server.ServerTool {
 			}
 
 			// return result
-			return mcp.NewToolResultJSON[resp.List](resp.SliceToList(moduleNames))
+			return mcp.NewToolResultJSON[resp.List](resp.SliceToPagedList(moduleNames, page))
 		},
 	}
 }
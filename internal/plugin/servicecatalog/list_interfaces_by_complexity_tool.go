@@ -3,11 +3,13 @@ package servicecatalog
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
 )
 
 // NewListInterfacesByComplexityTool returns the MCP tool definition and its handler for listing interfaces by complexity.
@@ -15,8 +17,10 @@ func (h *mcpHandler) listInterfacesByComplexityTool() server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool(
 			"list_interfaces_by_complexity",
-			mcp.WithDescription("Lists all interfaces in the catalog ordered DESC on complexity limited up to limit_to interfaces."),
+			mcp.WithDescription("Lists all interfaces in the catalog ordered DESC on complexity limited up to limit_to interfaces, returned in pages. "+
+				"The complexity score combines the number of methods, consuming modules and consuming teams with the number of operations and schema size of the OpenAPI specification."),
 			mcp.WithNumber("limit_to", mcp.Description("Maximum number of interfaces to list.")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[InterfaceDescriptorList](),
@@ -24,26 +28,43 @@ func (h *mcpHandler) listInterfacesByComplexityTool() server.ServerTool {
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			// extract params
 			limit := request.GetInt("limit_to", 20)
+			if limit < 1 {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid limit_to %d", limit),
+						"limit_to",
+						"Use a positive number")), nil
+			}
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
 
 			// call business logic
-			interfaces, err := h.repo.ListInterfacesByComplexity(ctx, limit)
+			interfaces, values, profile, err := h.listInterfaceComplexity(ctx)
 			if err != nil {
 				return mcp.NewToolResultError(
 					resp.InternalError(ctx,
 						fmt.Sprintf("error listing interfaces by complexity: %s", err))), nil
 			}
 
+			maxima := complexity.Maxima(values)
 			results := []InterfaceDescriptor{}
-			for _, i := range interfaces {
+			for idx, i := range interfaces {
 				results = append(results, InterfaceDescriptor{
 					InterfaceID:     i.InterfaceID,
 					Description:     i.Description,
 					Kind:            i.Kind,
-					ComplexityScore: i.MethodCount,
+					ComplexityScore: float32(profile.Score(values[idx], maxima)),
 				})
 			}
+			sort.SliceStable(results, func(i, j int) bool {
+				return results[i].ComplexityScore > results[j].ComplexityScore
+			})
+
+			results, nextCursor := resp.Paginate(results[0:min(limit, len(results))], page)
 			return mcp.NewToolResultJSON[InterfaceDescriptorList](InterfaceDescriptorList{
 				Interfaces: results,
+				NextCursor: nextCursor,
 			})
 		},
 	}
@@ -9,7 +9,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
 )
 
 func TestListInterfacesByComplexityTool_SuccessWithLimit(t *testing.T) {
@@ -18,39 +20,42 @@ func TestListInterfacesByComplexityTool_SuccessWithLimit(t *testing.T) {
 	defer ctrl.Finish()
 
 	repository := repo.NewMockCataloger(ctrl)
-	repository.EXPECT().ListInterfacesByComplexity(gomock.Any(), 5).Return([]repo.Interface{
-		{InterfaceID: "interface1", MethodCount: 10},
-		{InterfaceID: "interface2", MethodCount: 5},
+	repository.EXPECT().ListInterfaceMetrics(gomock.Any()).Return([]repo.Interface{
+		{InterfaceID: "interface1", MethodCount: 2, ConsumerCount: intPointer(1), ConsumingTeamCount: intPointer(1)},
+		{InterfaceID: "interface2", MethodCount: 10, ConsumerCount: intPointer(4), ConsumingTeamCount: intPointer(2)},
+		{InterfaceID: "interface3", MethodCount: 1},
 	}, nil)
+	repository.EXPECT().GetInterfaceComplexityProfile(gomock.Any()).Return(complexity.DefaultConfig().InterfaceProfile, nil)
 
 	tool := NewMCPHandler(repository, nil).listInterfacesByComplexityTool()
 
 	// When
 	result, err := tool.Handler(context.Background(), createRequest("list_interfaces_by_complexity", map[string]interface{}{
-		"limit_to": 5,
+		"limit_to": 2,
 	}))
 
 	// Then
 	assert.NoError(t, err)
 	textResult := result.Content[0].(mcp.TextContent)
-	assert.Contains(t, textResult.Text, "interface1")
-	assert.Contains(t, textResult.Text, "10")
-	assert.Contains(t, textResult.Text, "interface2")
-	assert.Contains(t, textResult.Text, "5")
+	assert.Contains(t, textResult.Text, `{"interfaces":[{"InterfaceID":"interface2","Description":"","Kind":"","ComplexityScore":70},{"InterfaceID":"interface1","Description":"","Kind":"","ComplexityScore":21.25}]}`)
 }
 
-func TestListInterfacesByComplexityTool_SuccessWithoutLimit(t *testing.T) {
+func TestListInterfacesByComplexityTool_WithOpenAPISpecification(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	repository := repo.NewMockCataloger(ctrl)
-	repository.EXPECT().ListInterfacesByComplexity(gomock.Any(), 20).Return([]repo.Interface{
-		{InterfaceID: "interfaceA", MethodCount: 100},
-		{InterfaceID: "interfaceB", MethodCount: 50},
+	repository.EXPECT().ListInterfaceMetrics(gomock.Any()).Return([]repo.Interface{
+		{InterfaceID: "interface1", MethodCount: 1, OpenAPISpecs: stringPointer("specs/interface1.json")},
+		{InterfaceID: "interface2", MethodCount: 1},
 	}, nil)
+	repository.EXPECT().GetInterfaceComplexityProfile(gomock.Any()).Return(complexity.DefaultConfig().InterfaceProfile, nil)
 
-	tool := NewMCPHandler(repository, nil).listInterfacesByComplexityTool()
+	loader := spec.NewMockLoader(ctrl)
+	loader.EXPECT().Load(gomock.Any(), "specs/interface1.json").Return([]byte(openAPISpecification), true, nil)
+
+	tool := NewMCPHandler(repository, nil, WithSpecLoader(loader)).listInterfacesByComplexityTool()
 
 	// When
 	result, err := tool.Handler(context.Background(), createRequest("list_interfaces_by_complexity", nil))
@@ -58,10 +63,7 @@ func TestListInterfacesByComplexityTool_SuccessWithoutLimit(t *testing.T) {
 	// Then
 	assert.NoError(t, err)
 	textResult := result.Content[0].(mcp.TextContent)
-	assert.Contains(t, textResult.Text, "interfaceA")
-	assert.Contains(t, textResult.Text, "100")
-	assert.Contains(t, textResult.Text, "interfaceB")
-	assert.Contains(t, textResult.Text, "50")
+	assert.Contains(t, textResult.Text, `{"interfaces":[{"InterfaceID":"interface1","Description":"","Kind":"","ComplexityScore":40},{"InterfaceID":"interface2","Description":"","Kind":"","ComplexityScore":25}]}`)
 }
 
 func TestListInterfacesByComplexityTool_Error(t *testing.T) {
@@ -70,7 +72,7 @@ func TestListInterfacesByComplexityTool_Error(t *testing.T) {
 	defer ctrl.Finish()
 
 	repo := repo.NewMockCataloger(ctrl)
-	repo.EXPECT().ListInterfacesByComplexity(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to list interfaces"))
+	repo.EXPECT().ListInterfaceMetrics(gomock.Any()).Return(nil, errors.New("failed to list interfaces"))
 
 	tool := NewMCPHandler(repo, nil).listInterfacesByComplexityTool()
 
@@ -81,5 +83,26 @@ func TestListInterfacesByComplexityTool_Error(t *testing.T) {
 	assert.NoError(t, err)
 	expectError(t, result, `"status": "error"`)
 	textResult := result.Content[0].(mcp.TextContent)
-	assert.Contains(t, textResult.Text, "error listing interfaces by complexity: failed to list interfaces")
+	assert.Contains(t, textResult.Text, "error listing interfaces by complexity: error listing interface metrics: failed to list interfaces")
+}
+
+func TestListInterfacesByComplexityTool_InvalidLimit(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+
+	tool := NewMCPHandler(repository, nil).listInterfacesByComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_interfaces_by_complexity", map[string]interface{}{
+		"limit_to": -1,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Invalid limit_to -1")
 }
@@ -17,6 +17,7 @@ func (h *mcpHandler) listInterfacesTool() server.ServerTool {
 			"list_interfaces",
 			mcp.WithDescription("Lists all interfaces (=web-api's) in the catalog"),
 			mcp.WithString("filter_keyword", mcp.Required(), mcp.Description("The keyword to filter interfaces by.")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[InterfaceDescriptorList](),
@@ -30,6 +31,10 @@ func (h *mcpHandler) listInterfacesTool() server.ServerTool {
 						"filter_keyword",
 						"Use a non-empty string as keyword")), nil
 			}
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
 
 			// call business logic
 			interfaces, err := h.repo.ListInterfaces(ctx, keyword)
@@ -47,8 +52,10 @@ func (h *mcpHandler) listInterfacesTool() server.ServerTool {
 					Kind:        i.Kind,
 				})
 			}
+			results, nextCursor := resp.Paginate(results, page)
 			return mcp.NewToolResultJSON[InterfaceDescriptorList](InterfaceDescriptorList{
 				Interfaces: results,
+				NextCursor: nextCursor,
 			})
 		},
 	}
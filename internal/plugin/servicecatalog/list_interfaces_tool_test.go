@@ -2,6 +2,7 @@ package servicecatalog
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -41,6 +42,50 @@ func TestListInterfacesTool_SuccessWithKeyword(t *testing.T) {
 	assert.Contains(t, textResult.Text, "kind2")
 }
 
+func TestListInterfacesTool_Paged(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListInterfaces(gomock.Any(), "test").Return([]repo.Interface{
+		{InterfaceID: "interface1"},
+		{InterfaceID: "interface2"},
+		{InterfaceID: "interface3"},
+	}, nil).Times(2)
+
+	tool := NewMCPHandler(repository, nil).listInterfacesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_interfaces", map[string]interface{}{
+		"filter_keyword": "test",
+		"page_size":      2,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	firstPage := InterfaceDescriptorList{}
+	assert.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &firstPage))
+	assert.Len(t, firstPage.Interfaces, 2)
+	assert.Equal(t, "interface1", firstPage.Interfaces[0].InterfaceID)
+	assert.NotEmpty(t, firstPage.NextCursor)
+
+	// When
+	result, err = tool.Handler(context.Background(), createRequest("list_interfaces", map[string]interface{}{
+		"filter_keyword": "test",
+		"page_size":      2,
+		"cursor":         firstPage.NextCursor,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	secondPage := InterfaceDescriptorList{}
+	assert.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &secondPage))
+	assert.Len(t, secondPage.Interfaces, 1)
+	assert.Equal(t, "interface3", secondPage.Interfaces[0].InterfaceID)
+	assert.Empty(t, secondPage.NextCursor)
+}
+
 func TestListInterfacesTool_Error(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
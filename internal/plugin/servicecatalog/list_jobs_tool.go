@@ -0,0 +1,45 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+)
+
+// NewListJobsTool returns the MCP tool definition and its handler for listing scheduled jobs.
+func (h *mcpHandler) listJobsTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"list_jobs",
+			mcp.WithDescription("Lists all scheduled (batch) jobs in the catalog, optionally filtered by a keyword."),
+			mcp.WithString("filter_keyword", mcp.Description("The keyword to filter jobs by.")),
+			resp.WithPagination(),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[resp.List](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			keyword := request.GetString("filter_keyword", "")
+
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+
+			// call business logic
+			jobs, err := h.repo.ListJobs(ctx, keyword)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error listing jobs with keyword %s: %s", keyword, err))), nil
+			}
+
+			return mcp.NewToolResultJSON[resp.List](resp.SliceToPagedList(jobs, page))
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+func TestListJobsTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repo.NewMockCataloger(ctrl)
+	repo.EXPECT().ListJobs(gomock.Any(), "").Return([]string{"job1", "job2"}, nil)
+
+	tool := NewMCPHandler(repo, nil).listJobsTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_jobs", nil))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Equal(t, `{"names":["job1","job2"]}`, textResult.Text)
+}
+
+func TestListJobsTool_SuccessWithKeyword(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repo.NewMockCataloger(ctrl)
+	repo.EXPECT().ListJobs(gomock.Any(), "settle").Return([]string{"settlementJob"}, nil)
+
+	tool := NewMCPHandler(repo, nil).listJobsTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_jobs", map[string]interface{}{
+		"filter_keyword": "settle",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Equal(t, `{"names":["settlementJob"]}`, textResult.Text)
+}
+
+func TestListJobsTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repo.NewMockCataloger(ctrl)
+	repo.EXPECT().ListJobs(gomock.Any(), "").Return(nil, errors.New("failed to list jobs"))
+
+	tool := NewMCPHandler(repo, nil).listJobsTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_jobs", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "failed to list jobs")
+}
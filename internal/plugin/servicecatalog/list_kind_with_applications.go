@@ -17,6 +17,7 @@ func (h *mcpHandler) listModulesWithKindTool() server.ServerTool {
 			"list_modules_with_kind",
 			mcp.WithDescription("List all modules that are of this kind"),
 			mcp.WithString("kind_id", mcp.Required(), mcp.Description("The ID of the kind")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[resp.List](),
@@ -30,6 +31,11 @@ func (h *mcpHandler) listModulesWithKindTool() server.ServerTool {
 					"Use a valid kind identifier")), nil
 			}
 
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+
 			// call business logic
 			moduleNames, exists, err := h.repo.ListModulesWithKind(ctx, kindID)
 			if err != nil {
@@ -47,7 +53,7 @@ func (h *mcpHandler) listModulesWithKindTool() server.ServerTool {
 			}
 
 			// return result
-			return mcp.NewToolResultJSON[resp.List](resp.SliceToList(moduleNames))
+			return mcp.NewToolResultJSON[resp.List](resp.SliceToPagedList(moduleNames, page))
 		},
 	}
 }
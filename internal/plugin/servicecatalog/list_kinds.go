@@ -16,11 +16,17 @@ func (h *mcpHandler) listKindsTool() server.ServerTool {
 		Tool: mcp.NewTool(
 			"list_kinds",
 			mcp.WithDescription("Lists all module kinds in the catalog."),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[resp.List](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
 
 			// call business logic
 			flows, err := h.repo.ListKinds(ctx)
@@ -30,7 +36,7 @@ func (h *mcpHandler) listKindsTool() server.ServerTool {
 						fmt.Sprintf("error listing kinds: %s", err))), nil
 			}
 
-			return mcp.NewToolResultJSON[resp.List](resp.SliceToList(flows))
+			return mcp.NewToolResultJSON[resp.List](resp.SliceToPagedList(flows, page))
 		},
 	}
 }
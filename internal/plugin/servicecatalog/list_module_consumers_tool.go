@@ -8,6 +8,7 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
 )
 
 // NewListMDatabaseConsumersTool returns the MCP tool definition and its handler for listing interfaces.
@@ -17,9 +18,11 @@ func (h *mcpHandler) listModuleConsumersTool() server.ServerTool {
 			"list_module_consumers",
 			mcp.WithDescription("List all modules that consume a given gradle module"),
 			mcp.WithString("module_id", mcp.Required(), mcp.Description("The ID of the gradle module to list consumers for")),
+			mcp.WithBoolean("transitive", mcp.Description("Return all modules that transitively depend on the gradle module (=everything that rebuilds), with depth and diamond dependencies")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
-			mcp.WithOutputSchema[resp.List](),
+			mcp.WithOutputSchema[GradleModuleList](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			// extract params
@@ -30,8 +33,31 @@ func (h *mcpHandler) listModuleConsumersTool() server.ServerTool {
 						"module_id",
 						"Use a valid module identifier")), nil
 			}
+			transitive := request.GetBool("transitive", false)
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
 
 			// call business logic
+			if transitive {
+				closure, exists, err := h.repo.GetGradleClosure(ctx, moduleID, repo.DirectionConsumers)
+				if err != nil {
+					return mcp.NewToolResultError(resp.InternalError(ctx,
+						fmt.Sprintf("error getting transitive consumers of module %s: %s", moduleID, err))), nil
+				}
+				if !exists {
+					return mcp.NewToolResultError(
+						resp.NotFound(ctx,
+							fmt.Sprintf("Module with ID %s not found", moduleID),
+							"module_id",
+							h.idx.Search(ctx, moduleID, 10).Modules,
+						)), nil
+				}
+
+				return mcp.NewToolResultJSON[GradleModuleList](closureToGradleModuleList(closure, page))
+			}
+
 			moduleNames, exists, err := h.repo.ListConsumersOfGradleModule(ctx, moduleID)
 			if err != nil {
 				return mcp.NewToolResultError(resp.InternalError(ctx,
@@ -46,7 +72,8 @@ func (h *mcpHandler) listModuleConsumersTool() server.ServerTool {
 					)), nil
 			}
 
-			return mcp.NewToolResultJSON[resp.List](resp.SliceToList(moduleNames))
+			moduleNames, nextCursor := resp.Paginate(moduleNames, page)
+			return mcp.NewToolResultJSON[GradleModuleList](GradleModuleList{Names: moduleNames, NextCursor: nextCursor})
 		},
 	}
 }
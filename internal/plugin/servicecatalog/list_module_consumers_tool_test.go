@@ -0,0 +1,141 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestListModuleConsumersTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListConsumersOfGradleModule(gomock.Any(), "lib1").Return([]string{"module1", "module2"}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).listModuleConsumersTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_module_consumers", map[string]interface{}{
+		"module_id": "lib1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Equal(t, `{"names":["module1","module2"]}`, textResult.Text)
+}
+
+func TestListModuleConsumersTool_SuccessTransitive(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetGradleClosure(gomock.Any(), "lib1", repo.DirectionConsumers).Return(repo.GradleClosure{
+		ModuleID:  "lib1",
+		Direction: repo.DirectionConsumers,
+		Entries: []repo.GradleClosureEntry{
+			{ModuleID: "module1", Depth: 1, Via: []string{"lib1"}},
+			{ModuleID: "module2", Depth: 2, Via: []string{"module1"}},
+		},
+		Diamonds: []repo.GradleClosureEntry{},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).listModuleConsumersTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_module_consumers", map[string]interface{}{
+		"module_id":  "lib1",
+		"transitive": true,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"names":["module1","module2"]`)
+	assert.Contains(t, textResult.Text, `{"moduleID":"module2","depth":2,"via":["module1"]}`)
+}
+
+func TestListModuleConsumersTool_NotFoundTransitive(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetGradleClosure(gomock.Any(), "nonexistent_module", repo.DirectionConsumers).Return(repo.GradleClosure{}, false, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_module", 10).Return(search.Result{Modules: []string{"suggested_module"}})
+
+	tool := NewMCPHandler(repository, idx).listModuleConsumersTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_module_consumers", map[string]interface{}{
+		"module_id":  "nonexistent_module",
+		"transitive": true,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Module with ID nonexistent_module not found")
+	assert.Contains(t, textResult.Text, "suggested_module")
+}
+
+func TestListModuleConsumersTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListConsumersOfGradleModule(gomock.Any(), "module_with_error").Return(nil, false, errors.New("failed to list consumers"))
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).listModuleConsumersTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_module_consumers", map[string]interface{}{
+		"module_id": "module_with_error",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error getting consumers of module module_with_error: failed to list consumers")
+}
+
+func TestListModuleConsumersTool_MissingModuleID(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).listModuleConsumersTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_module_consumers", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Missing module_id")
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/samber/lo"
 
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
 )
 
 // NewListModulesByComplexityTool returns the MCP tool definition and its handler for listing modules.
@@ -15,8 +17,11 @@ func (h *mcpHandler) listModulesByComplexityTool() server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool(
 			"list_modules_by_complexity",
-			mcp.WithDescription("Lists all modules in the catalog ordered DESC on complexity limited up to limit_to modules."),
+			mcp.WithDescription("Lists all modules in the catalog ordered DESC on complexity limited up to limit_to modules, returned in pages. "+
+				"The complexity score is calculated with a named scoring profile that determines which metrics participate, their weights and normalization."),
 			mcp.WithNumber("limit_to", mcp.Description("Maximum number of modules to return.")),
+			mcp.WithString("profile", mcp.Description("Name of the complexity scoring profile, for example default, coupling or balanced. Uses the configured default profile when omitted.")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[ModuleDescriptorList](),
@@ -24,14 +29,39 @@ func (h *mcpHandler) listModulesByComplexityTool() server.ServerTool {
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			// extract params
 			limit := request.GetInt("limit_to", 20)
+			if limit < 1 {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid limit_to %d", limit),
+						"limit_to",
+						"Use a positive number")), nil
+			}
+			profile := request.GetString("profile", "")
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
 
 			// call business logic
-			modules, err := h.repo.ListModulesByCompexity(ctx, limit)
+			modules, exists, err := h.repo.ListModulesByCompexity(ctx, limit, profile)
 			if err != nil {
 				return mcp.NewToolResultError(
 					resp.InternalError(ctx,
 						fmt.Sprintf("error listing modules by complexity: %s", err))), nil
 			}
+			if !exists {
+				profiles, err := h.repo.ListComplexityProfiles(ctx)
+				if err != nil {
+					return mcp.NewToolResultError(
+						resp.InternalError(ctx,
+							fmt.Sprintf("error listing complexity profiles: %s", err))), nil
+				}
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Complexity profile %s not found", profile),
+						"profile",
+						lo.Map(profiles, func(p complexity.Profile, _ int) string { return p.Name }),
+					)), nil
+			}
 
 			results := []ModuleDescriptor{}
 			for _, mod := range modules {
@@ -42,8 +72,10 @@ func (h *mcpHandler) listModulesByComplexityTool() server.ServerTool {
 					ComplexityScore: mod.ComplexityScore,
 				})
 			}
+			results, nextCursor := resp.Paginate(results, page)
 			return mcp.NewToolResultJSON[ModuleDescriptorList](ModuleDescriptorList{
-				Modules: results,
+				Modules:    results,
+				NextCursor: nextCursor,
 			})
 		},
 	}
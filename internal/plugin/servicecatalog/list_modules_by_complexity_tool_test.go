@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
 )
 
@@ -18,10 +19,10 @@ func TestListModulesByComplexityTool_SuccessWithLimit(t *testing.T) {
 	defer ctrl.Finish()
 
 	repository := repo.NewMockCataloger(ctrl)
-	repository.EXPECT().ListModulesByCompexity(gomock.Any(), 5).Return([]repo.Module{
+	repository.EXPECT().ListModulesByCompexity(gomock.Any(), 5, "").Return([]repo.Module{
 		{ModuleID: "module1", Name: "Module One", Description: "Desc One", ComplexityScore: 10.5},
 		{ModuleID: "module2", Name: "Module Two", Description: "Desc Two", ComplexityScore: 8.2},
-	}, nil)
+	}, true, nil)
 
 	tool := NewMCPHandler(repository, nil).listModulesByComplexityTool()
 
@@ -47,10 +48,10 @@ func TestListModulesByComplexityTool_SuccessWithoutLimit(t *testing.T) {
 	defer ctrl.Finish()
 
 	repository := repo.NewMockCataloger(ctrl)
-	repository.EXPECT().ListModulesByCompexity(gomock.Any(), 20).Return([]repo.Module{
+	repository.EXPECT().ListModulesByCompexity(gomock.Any(), 20, "").Return([]repo.Module{
 		{ModuleID: "moduleA", Name: "Module A", Description: "Desc A", ComplexityScore: 50.1},
 		{ModuleID: "moduleB", Name: "Module B", Description: "Desc B", ComplexityScore: 30.9},
-	}, nil)
+	}, true, nil)
 
 	tool := NewMCPHandler(repository, nil).listModulesByComplexityTool()
 
@@ -68,13 +69,61 @@ func TestListModulesByComplexityTool_SuccessWithoutLimit(t *testing.T) {
 	assert.Contains(t, textResult.Text, "30.9")
 }
 
+func TestListModulesByComplexityTool_SuccessWithProfile(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListModulesByCompexity(gomock.Any(), 20, "coupling").Return([]repo.Module{
+		{ModuleID: "moduleA", Name: "Module A", Description: "Desc A", ComplexityScore: 87.5},
+	}, true, nil)
+
+	tool := NewMCPHandler(repository, nil).listModulesByComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_modules_by_complexity", map[string]interface{}{
+		"profile": "coupling",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "moduleA")
+	assert.Contains(t, textResult.Text, "87.5")
+}
+
+func TestListModulesByComplexityTool_ProfileNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListModulesByCompexity(gomock.Any(), 20, "unknown").Return([]repo.Module{}, false, nil)
+	repository.EXPECT().ListComplexityProfiles(gomock.Any()).Return(complexity.DefaultConfig().Profiles, nil)
+
+	tool := NewMCPHandler(repository, nil).listModulesByComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_modules_by_complexity", map[string]interface{}{
+		"profile": "unknown",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Complexity profile unknown not found")
+	assert.Contains(t, textResult.Text, "coupling")
+}
+
 func TestListModulesByComplexityTool_Error(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	repo := repo.NewMockCataloger(ctrl)
-	repo.EXPECT().ListModulesByCompexity(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to list modules"))
+	repo.EXPECT().ListModulesByCompexity(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, false, errors.New("failed to list modules"))
 
 	tool := NewMCPHandler(repo, nil).listModulesByComplexityTool()
 
@@ -87,3 +136,24 @@ func TestListModulesByComplexityTool_Error(t *testing.T) {
 	textResult := result.Content[0].(mcp.TextContent)
 	assert.Contains(t, textResult.Text, "error listing modules by complexity: failed to list modules")
 }
+
+func TestListModulesByComplexityTool_InvalidLimit(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+
+	tool := NewMCPHandler(repository, nil).listModulesByComplexityTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_modules_by_complexity", map[string]interface{}{
+		"limit_to": -1,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Invalid limit_to -1")
+}
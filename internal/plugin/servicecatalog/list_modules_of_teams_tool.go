@@ -18,6 +18,7 @@ func (h *mcpHandler) listModulesOfTeamsTool() server.ServerTool {
 			"list_modules_of_teams",
 			mcp.WithDescription("List all modules owned by a team"),
 			mcp.WithString("team_id", mcp.Required(), mcp.Description("The ID of the team to list modules for")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[resp.List](),
@@ -32,6 +33,11 @@ func (h *mcpHandler) listModulesOfTeamsTool() server.ServerTool {
 					"Use a valid team identifier")), nil
 			}
 
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+
 			// call business logic
 			moduleNames, exists, err := h.repo.ListModulesOfTeam(ctx, teamID)
 			if err != nil {
@@ -47,7 +53,7 @@ func (h *mcpHandler) listModulesOfTeamsTool() server.ServerTool {
 
 			}
 
-			return mcp.NewToolResultJSON[resp.List](resp.SliceToList(moduleNames))
+			return mcp.NewToolResultJSON[resp.List](resp.SliceToPagedList(moduleNames, page))
 		},
 	}
 }
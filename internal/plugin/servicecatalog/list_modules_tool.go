@@ -3,53 +3,113 @@ package servicecatalog
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/samber/lo"
 
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
 )
 
 // NewListModulesTool returns the MCP tool definition and its handler for listing modules.
 func (h *mcpHandler) listModulesTool() server.ServerTool {
+	sortFields := lo.Map(repo.ModuleSortFields, func(f repo.ModuleSortField, _ int) string { return string(f) })
 	return server.ServerTool{
 		Tool: mcp.NewTool(
 			"list_modules",
-			mcp.WithDescription("Lists all modules in the catalog."),
-			mcp.WithString("filter_keyword", mcp.Required(), mcp.Description("The keyword to filter modules by.")),
+			mcp.WithDescription("Lists the modules in the catalog that match all given filters, ordered on the given sort field. "+
+				"The complexity score is calculated with the default scoring profile."),
+			mcp.WithString("filter_keyword", mcp.Description("The keyword to filter module IDs by.")),
+			mcp.WithString("team_id", mcp.Description("Only modules owned by this team.")),
+			mcp.WithString("kind_id", mcp.Description("Only modules of this application kind.")),
+			mcp.WithString("flow_id", mcp.Description("Only modules participating in this flow.")),
+			mcp.WithString("database_id", mcp.Description("Only modules using this database.")),
+			mcp.WithNumber("min_line_count", mcp.Description("Only modules with at least this number of lines of code.")),
+			mcp.WithNumber("max_line_count", mcp.Description("Only modules with at most this number of lines of code.")),
+			mcp.WithNumber("min_complexity", mcp.Description("Only modules with at least this complexity score.")),
+			mcp.WithNumber("max_complexity", mcp.Description("Only modules with at most this complexity score.")),
+			mcp.WithString("sort_by", mcp.Enum(sortFields...), mcp.DefaultString(string(repo.SortOnLineCount)), mcp.Description("The field to order the modules on.")),
+			mcp.WithString("sort_order", mcp.Enum("asc", "desc"), mcp.DefaultString("desc"), mcp.Description("Order ascending or descending.")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[ModuleDescriptorList](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			// extract params
-			keyword, err := request.RequireString("filter_keyword")
-			if err != nil {
+			filter := repo.ModuleFilter{
+				Keyword:       request.GetString("filter_keyword", ""),
+				Team:          request.GetString("team_id", ""),
+				Kind:          request.GetString("kind_id", ""),
+				Flow:          request.GetString("flow_id", ""),
+				Database:      request.GetString("database_id", ""),
+				MinLineCount:  optionalInt(request, "min_line_count"),
+				MaxLineCount:  optionalInt(request, "max_line_count"),
+				MinComplexity: optionalFloat(request, "min_complexity"),
+				MaxComplexity: optionalFloat(request, "max_complexity"),
+				SortBy:        repo.ModuleSortField(request.GetString("sort_by", string(repo.SortOnLineCount))),
+			}
+			if !slices.Contains(repo.ModuleSortFields, filter.SortBy) {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid sort_by %s", filter.SortBy),
+						"sort_by",
+						fmt.Sprintf("Use one of %v", sortFields))), nil
+			}
+			sortOrder := request.GetString("sort_order", "desc")
+			if sortOrder != "asc" && sortOrder != "desc" {
 				return mcp.NewToolResultError(
-					resp.InvalidInput(ctx, "Missing filter_keyword",
-						"filter_keyword",
-						"Use a non-empty string as keyword")), nil
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid sort_order %s", sortOrder),
+						"sort_order",
+						"Use asc or desc")), nil
+			}
+			filter.Descending = sortOrder == "desc"
+
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
 			}
 
 			// call business logic
-			modules, err := h.repo.ListModules(ctx, keyword)
+			modules, err := h.repo.FilterModules(ctx, filter)
 			if err != nil {
 				return mcp.NewToolResultError(
 					resp.InternalError(ctx,
-						fmt.Sprintf("error listing modules with keyword %s: %s", keyword, err))), nil
+						fmt.Sprintf("error listing modules with keyword %s: %s", filter.Keyword, err))), nil
 			}
 
 			results := []ModuleDescriptor{}
 			for _, mod := range modules {
 				results = append(results, ModuleDescriptor{
-					ModuleID:    mod.ModuleID,
-					Name:        mod.Name,
-					Description: mod.Description,
+					ModuleID:        mod.ModuleID,
+					Name:            mod.Name,
+					Description:     mod.Description,
+					LineCount:       mod.LineCount,
+					ComplexityScore: mod.ComplexityScore,
 				})
 			}
+			results, nextCursor := resp.Paginate(results, page)
 			return mcp.NewToolResultJSON[ModuleDescriptorList](ModuleDescriptorList{
-				Modules: results,
+				Modules:    results,
+				NextCursor: nextCursor,
 			})
 		},
 	}
 }
+
+func optionalInt(request mcp.CallToolRequest, name string) *int {
+	if _, found := request.GetArguments()[name]; !found {
+		return nil
+	}
+	value := request.GetInt(name, 0)
+	return &value
+}
+
+func optionalFloat(request mcp.CallToolRequest, name string) *float64 {
+	if _, found := request.GetArguments()[name]; !found {
+		return nil
+	}
+	value := request.GetFloat(name, 0)
+	return &value
+}
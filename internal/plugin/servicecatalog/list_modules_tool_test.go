@@ -18,7 +18,11 @@ func TestListModulesTool_SuccessWithKeyword(t *testing.T) {
 	defer ctrl.Finish()
 
 	repository := repo.NewMockCataloger(ctrl)
-	repository.EXPECT().ListModules(gomock.Any(), "test").Return([]repo.Module{
+	repository.EXPECT().FilterModules(gomock.Any(), repo.ModuleFilter{
+		Keyword:    "test",
+		SortBy:     repo.SortOnLineCount,
+		Descending: true,
+	}).Return([]repo.Module{
 		{ModuleID: "module1", Name: "Module One", Description: "Desc One"},
 		{ModuleID: "module2", Name: "Module Two", Description: "Desc Two"},
 	}, nil)
@@ -39,13 +43,80 @@ func TestListModulesTool_SuccessWithKeyword(t *testing.T) {
 	assert.Contains(t, textResult.Text, "Module Two")
 }
 
+func TestListModulesTool_SuccessWithFilters(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	minLineCount := 1000
+	maxComplexity := 50.0
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().FilterModules(gomock.Any(), repo.ModuleFilter{
+		Team:          "team1",
+		Kind:          "webapp",
+		Flow:          "flow1",
+		Database:      "database1",
+		MinLineCount:  &minLineCount,
+		MaxComplexity: &maxComplexity,
+		SortBy:        repo.SortOnComplexity,
+		Descending:    false,
+	}).Return([]repo.Module{
+		{ModuleID: "module1", Name: "Module One", LineCount: 1200, ComplexityScore: 12.5},
+	}, nil)
+
+	tool := NewMCPHandler(repository, nil).listModulesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_modules", map[string]interface{}{
+		"team_id":        "team1",
+		"kind_id":        "webapp",
+		"flow_id":        "flow1",
+		"database_id":    "database1",
+		"min_line_count": 1000,
+		"max_complexity": 50,
+		"sort_by":        "complexity",
+		"sort_order":     "asc",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Equal(t, `{"modules":[{"ModuleID":"module1","Name":"Module One","Description":"","LineCount":1200,"ComplexityScore":12.5}]}`, textResult.Text)
+}
+
+func TestListModulesTool_InvalidSort(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil).listModulesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_modules", map[string]interface{}{
+		"sort_by": "colour",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Invalid sort_by colour")
+
+	// When
+	result, err = tool.Handler(context.Background(), createRequest("list_modules", map[string]interface{}{
+		"sort_order": "random",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Invalid sort_order random")
+}
+
 func TestListModulesTool_Error(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	repo := repo.NewMockCataloger(ctrl)
-	repo.EXPECT().ListModules(gomock.Any(), "error").Return(nil, errors.New("failed to list modules"))
+	repo.EXPECT().FilterModules(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to list modules"))
 
 	tool := NewMCPHandler(repo, nil).listModulesTool()
 
@@ -61,21 +132,25 @@ func TestListModulesTool_Error(t *testing.T) {
 	assert.Contains(t, textResult.Text, "error listing modules with keyword error: failed to list modules")
 }
 
-func TestListModulesTool_MissingKeyword(t *testing.T) {
+func TestListModulesTool_WithoutFilters(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	repo := repo.NewMockCataloger(ctrl)
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().FilterModules(gomock.Any(), repo.ModuleFilter{
+		SortBy:     repo.SortOnLineCount,
+		Descending: true,
+	}).Return([]repo.Module{{ModuleID: "module1"}}, nil)
 
-	tool := NewMCPHandler(repo, nil).listModulesTool()
+	tool := NewMCPHandler(repository, nil).listModulesTool()
 
 	// When
 	result, err := tool.Handler(context.Background(), createRequest("list_modules", nil))
 
 	// Then
 	assert.NoError(t, err)
-	expectError(t, result, `"status": "invalid_input"`)
+	assert.False(t, result.IsError)
 	textResult := result.Content[0].(mcp.TextContent)
-	assert.Contains(t, textResult.Text, "Missing filter_keyword")
+	assert.Contains(t, textResult.Text, "module1")
 }
@@ -0,0 +1,74 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+)
+
+// NewListTeamDependenciesTool returns the MCP tool definition and its handler for listing dependencies between teams.
+func (h *mcpHandler) listTeamDependenciesTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"list_team_dependencies",
+			mcp.WithDescription("Aggregates the module interface graph to the level of owning teams. "+
+				"For a given team returns which teams it consumes interfaces from and which teams consume its interfaces; without team returns all team pairs. "+
+				"Each dependency has the number of module edges and the interfaces involved, ordered DESC on edge count."),
+			mcp.WithString("team_id", mcp.Description("The ID of the team. Leave empty to get the dependencies between all teams.")),
+			mcp.WithNumber("limit_to", mcp.Description("Maximum number of team dependencies to return per list.")),
+			resp.WithPagination(),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[TeamDependencyList](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			teamID := request.GetString("team_id", "")
+			limit := request.GetInt("limit_to", 50)
+			if limit < 1 {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid limit_to %d", limit),
+						"limit_to",
+						"Use a positive number")), nil
+			}
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+
+			// call business logic
+			dependencies, exists, err := h.repo.ListTeamDependencies(ctx, teamID)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error listing dependencies of team %s: %s", teamID, err))), nil
+			}
+			if !exists {
+				return mcp.NewToolResultError(
+					resp.NotFound(ctx,
+						fmt.Sprintf("Team with ID %s not found", teamID),
+						"team_id",
+						h.idx.Search(ctx, teamID, 10).Teams,
+					)), nil
+			}
+
+			// the lists are paged in parallel: the next page continues all lists that have more entries
+			result := TeamDependencyList{TeamID: dependencies.TeamID}
+			nextCursors := make([]string, 3)
+			result.ConsumesFrom, nextCursors[0] = resp.Paginate(dependencies.ConsumesFrom[0:min(limit, len(dependencies.ConsumesFrom))], page)
+			result.ConsumedBy, nextCursors[1] = resp.Paginate(dependencies.ConsumedBy[0:min(limit, len(dependencies.ConsumedBy))], page)
+			result.Dependencies, nextCursors[2] = resp.Paginate(dependencies.Dependencies[0:min(limit, len(dependencies.Dependencies))], page)
+			for _, nextCursor := range nextCursors {
+				if nextCursor != "" {
+					result.NextCursor = nextCursor
+				}
+			}
+
+			return mcp.NewToolResultJSON[TeamDependencyList](result)
+		},
+	}
+}
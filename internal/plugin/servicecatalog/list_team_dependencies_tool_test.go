@@ -0,0 +1,146 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestListTeamDependenciesTool_SuccessForTeam(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListTeamDependencies(gomock.Any(), "team1").Return(repo.TeamDependencies{
+		TeamID: "team1",
+		ConsumesFrom: []repo.TeamDependency{
+			{ConsumerTeam: "team1", ProviderTeam: "team2", EdgeCount: 3, Interfaces: []string{"interface2"}},
+		},
+		ConsumedBy: []repo.TeamDependency{
+			{ConsumerTeam: "team3", ProviderTeam: "team1", EdgeCount: 1, Interfaces: []string{"interface1"}},
+		},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).listTeamDependenciesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_team_dependencies", map[string]interface{}{
+		"team_id": "team1",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"consumesFrom":[{"consumerTeam":"team1","providerTeam":"team2","edgeCount":3,"interfaces":["interface2"]}]`)
+	assert.Contains(t, textResult.Text, `"consumedBy":[{"consumerTeam":"team3","providerTeam":"team1","edgeCount":1,"interfaces":["interface1"]}]`)
+}
+
+func TestListTeamDependenciesTool_SuccessAllTeamsLimited(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListTeamDependencies(gomock.Any(), "").Return(repo.TeamDependencies{
+		Dependencies: []repo.TeamDependency{
+			{ConsumerTeam: "team1", ProviderTeam: "team2", EdgeCount: 3},
+			{ConsumerTeam: "team3", ProviderTeam: "team1", EdgeCount: 1},
+		},
+	}, true, nil)
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).listTeamDependenciesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_team_dependencies", map[string]interface{}{
+		"limit_to": 1,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, `"consumerTeam":"team1","providerTeam":"team2"`)
+	assert.NotContains(t, textResult.Text, "team3")
+}
+
+func TestListTeamDependenciesTool_NotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListTeamDependencies(gomock.Any(), "nonexistent_team").Return(repo.TeamDependencies{}, false, nil)
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "nonexistent_team", 10).Return(search.Result{Teams: []string{"suggested_team"}})
+
+	tool := NewMCPHandler(repository, idx).listTeamDependenciesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_team_dependencies", map[string]interface{}{
+		"team_id": "nonexistent_team",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "not_found"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Team with ID nonexistent_team not found")
+	assert.Contains(t, textResult.Text, "suggested_team")
+}
+
+func TestListTeamDependenciesTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListTeamDependencies(gomock.Any(), "team_with_error").Return(repo.TeamDependencies{}, false, errors.New("failed to list dependencies"))
+
+	idx := search.NewMockIndex(ctrl)
+
+	tool := NewMCPHandler(repository, idx).listTeamDependenciesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_team_dependencies", map[string]interface{}{
+		"team_id": "team_with_error",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "error"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "error listing dependencies of team team_with_error: failed to list dependencies")
+}
+
+func TestListTeamDependenciesTool_InvalidLimit(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+
+	tool := NewMCPHandler(repository, nil).listTeamDependenciesTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("list_team_dependencies", map[string]interface{}{
+		"limit_to": -1,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, textResult.Text, "Invalid limit_to -1")
+}
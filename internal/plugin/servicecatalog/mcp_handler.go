@@ -2,50 +2,171 @@ package servicecatalog
 
 import (
 	"context"
+	"sync"
 
 	"github.com/mark3labs/mcp-go/server"
 
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
 )
 
 type mcpHandler struct {
-	repo repo.Cataloger
-	idx  search.Index
+	repo          repo.Cataloger
+	idx           search.Index
+	text          search.TextIndex
+	semantic      search.SemanticIndex
+	semanticStore repo.SemanticModelStorer
+	specs         spec.Loader
+	baseline      repo.Cataloger
+	baselineSpecs spec.Loader
+	queryCatalog  bool
+	openAPISizes  sync.Map // keyed on specification path
+}
+
+// Option configures an optional dependency of the mcpHandler.
+type Option func(h *mcpHandler)
+
+// WithSpecLoader configures where the interface specifications referred to by the catalog are read from.
+func WithSpecLoader(loader spec.Loader) Option {
+	return func(h *mcpHandler) {
+		h.specs = loader
+	}
+}
+
+// WithBaselineCatalog configures an older generation of the catalog to compare the current catalog with.
+func WithBaselineCatalog(baseline repo.Cataloger) Option {
+	return func(h *mcpHandler) {
+		h.baseline = baseline
+	}
+}
+
+// WithBaselineSpecLoader configures where the interface specifications referred to by the older generation of the catalog are read from.
+// It must be a different checkout than the one of the current catalog, otherwise a specification would be compared with itself.
+func WithBaselineSpecLoader(loader spec.Loader) Option {
+	return func(h *mcpHandler) {
+		h.baselineSpecs = loader
+	}
+}
+
+// WithQueryCatalog enables the tool to run read-only SQL queries on the catalog database, together with the resource describing its schema.
+func WithQueryCatalog() Option {
+	return func(h *mcpHandler) {
+		h.queryCatalog = true
+	}
+}
+
+// WithTextIndex uses the given full-text index instead of one over the catalog and specifications of the handler.
+func WithTextIndex(text search.TextIndex) Option {
+	return func(h *mcpHandler) {
+		h.text = text
+	}
+}
+
+// WithSemanticIndex uses the given semantic index instead of one trained on the catalog and specifications of the handler.
+func WithSemanticIndex(semantic search.SemanticIndex) Option {
+	return func(h *mcpHandler) {
+		h.semantic = semantic
+	}
+}
+
+// WithSemanticModelStore configures where the trained semantic model is kept between runs. Without it the model is
+// trained again on every start.
+func WithSemanticModelStore(store repo.SemanticModelStorer) Option {
+	return func(h *mcpHandler) {
+		h.semanticStore = store
+	}
 }
 
 // NewMCPHandler creates a new instance of mcpHandler.
-func NewMCPHandler(repo repo.Cataloger, idx search.Index) *mcpHandler {
-	return &mcpHandler{
-		repo: repo,
-		idx:  idx,
+func NewMCPHandler(repo repo.Cataloger, idx search.Index, options ...Option) *mcpHandler {
+	h := &mcpHandler{
+		repo:  repo,
+		idx:   idx,
+		specs: spec.NewLoader(""),
+	}
+	for _, option := range options {
+		option(h)
 	}
+	// both indexes search the same texts, so these are only collected once
+	documents := search.NewDocuments(repo, h.specs)
+	if h.text == nil {
+		h.text = search.NewTextIndex(documents)
+	}
+	if h.semantic == nil {
+		h.semantic = search.NewSemanticIndex(documents, h.semanticStore)
+	}
+	return h
 }
 
 // RegisterAllHandlers registers all tools, resources, and prompts with the MCP server.
 func (h *mcpHandler) RegisterAllHandlers(ctx context.Context, s *server.MCPServer) {
 	s.AddTools(
 		h.suggestCandidatesTool(),
+		h.searchCatalogTool(),
+		h.semanticSearchTool(),
 		h.listModulesTool(),
 		h.listModulesByComplexityTool(),
+		h.explainModuleComplexityTool(),
 		h.getSingleModuleTool(),
 		h.listInterfacesTool(),
 		h.listInterfacesByComplexityTool(),
+		h.explainInterfaceComplexityTool(),
 		h.getSingleInterfaceTool(),
+		h.getMethodTool(),
+		h.getOpenAPISpecificationTool(),
+		h.getRPLSpecificationTool(),
 		h.listModulesOfTeamsTool(),
+		h.getTeamTool(),
+		h.listTeamDependenciesTool(),
 		h.listMDatabaseConsumersTool(),
 		h.listInterfaceConsumersTool(),
 		h.listFlowsTool(),
 		h.listFlowParticipantsTool(),
+		h.getFlowTool(),
 		h.listKindsTool(),
 		h.listModulesWithKindTool(),
 		h.listModuleConsumersTool(),
 		h.listDependenciesTool(),
+		h.getDependencyGraphTool(),
+		h.exportGraphTool(),
+		h.analyzeImpactTool(),
+		h.findDependencyPathTool(),
+		h.listDependencyCyclesTool(),
+		h.getHygieneReportTool(),
+		h.listJobsTool(),
+		h.getJobTool(),
 	)
 
+	if h.baseline != nil {
+		// Comparing is only possible when an older generation of the catalog is configured
+		s.AddTools(
+			h.diffCatalogTool(),
+		)
+		if h.baselineSpecs != nil {
+			// Specifications can only be compared when they are read from a checkout of the older generation
+			s.AddTools(
+				h.compareOpenAPISpecificationTool(),
+			)
+		}
+	}
+
+	if h.queryCatalog {
+		// Free format queries are opt-in
+		s.AddTools(
+			h.queryCatalogTool(),
+		)
+		s.AddResources(
+			h.schemaResource(),
+		)
+	}
+
 	s.AddResources(
 		h.modulesResource(),
 	)
+	s.AddResourceTemplates(
+		h.modulesResourceTemplate(),
+	)
 
 	s.AddPrompts(
 		h.serviceCatalogPrompt(),
@@ -1,16 +1,31 @@
 package servicecatalog
 
+import (
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
+)
+
 // ModuleDescriptor is the short version of a Module
 type ModuleDescriptor struct {
 	ModuleID        string
 	Name            string
 	Description     string
+	LineCount       int     `json:",omitempty"`
 	ComplexityScore float32 `json:",omitempty"`
 }
 
 // ModuleDescriptorList wraps a list into a single object (because the API does not allow lists)
 type ModuleDescriptorList struct {
-	Modules []ModuleDescriptor `json:"modules"`
+	Modules    []ModuleDescriptor `json:"modules"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+}
+
+// ModuleList wraps a page of full modules into a single object
+type ModuleList struct {
+	Modules    []repo.Module `json:"modules"`
+	NextCursor string        `json:"next_cursor,omitempty"`
 }
 
 // InterfaceDescriptor is the short version of an Interface
@@ -18,10 +33,120 @@ type InterfaceDescriptor struct {
 	InterfaceID     string
 	Description     string
 	Kind            string
-	ComplexityScore int `yaml:",omitempty"`
+	ComplexityScore float32 `json:",omitempty"`
 }
 
 // InterfaceDescriptorList wraps a list into a single object (because the API does not allow lists)
 type InterfaceDescriptorList struct {
 	Interfaces []InterfaceDescriptor `json:"interfaces"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+}
+
+// InterfaceComplexityBreakdown explains the complexity score of an interface against all interfaces and the other interfaces exposed by the same team.
+type InterfaceComplexityBreakdown struct {
+	InterfaceID        string `json:"interfaceID"`
+	Team               string `json:"team,omitempty"`
+	TeamInterfaceCount int    `json:"teamInterfaceCount"`
+	complexity.Breakdown
+}
+
+// DependencyPathList wraps a list into a single object (because the API does not allow lists)
+type DependencyPathList struct {
+	FromModuleID string                `json:"fromModuleID"`
+	ToModuleID   string                `json:"toModuleID"`
+	Paths        []repo.DependencyPath `json:"paths"`
+}
+
+// DependencyCycleList wraps a list into a single object (because the API does not allow lists)
+type DependencyCycleList struct {
+	TotalCount int                    `json:"totalCount"`
+	Cycles     []repo.DependencyCycle `json:"cycles"`
+	NextCursor string                 `json:"next_cursor,omitempty"`
+}
+
+// TeamDependencyList wraps the paged dependencies between teams into a single object
+type TeamDependencyList struct {
+	TeamID       string                `json:"teamID,omitempty"`
+	ConsumesFrom []repo.TeamDependency `json:"consumesFrom,omitempty"`
+	ConsumedBy   []repo.TeamDependency `json:"consumedBy,omitempty"`
+	Dependencies []repo.TeamDependency `json:"dependencies,omitempty"`
+	NextCursor   string                `json:"next_cursor,omitempty"`
+}
+
+// GradleModuleList wraps a list of gradle modules into a single object (because the API does not allow lists).
+// In transitive mode it also contains the depth of every module and the diamond dependencies.
+type GradleModuleList struct {
+	Names      []string                  `json:"names"`
+	Entries    []repo.GradleClosureEntry `json:"entries,omitempty"`
+	Diamonds   []repo.GradleClosureEntry `json:"diamonds,omitempty"`
+	NextCursor string                    `json:"next_cursor,omitempty"`
+}
+
+func closureToGradleModuleList(closure repo.GradleClosure, page resp.Page) GradleModuleList {
+	entries, nextCursor := resp.Paginate(closure.Entries, page)
+	list := GradleModuleList{
+		Names:      []string{},
+		Entries:    entries,
+		Diamonds:   closure.Diamonds,
+		NextCursor: nextCursor,
+	}
+	for _, entry := range entries {
+		list.Names = append(list.Names, entry.ModuleID)
+	}
+	return list
+}
+
+// HygieneReport lists per category the catalog entities that are not used by any module
+type HygieneReport struct {
+	UnconsumedInterfaces      CleanupCandidateList `json:"unconsumedInterfaces"`
+	UnexposedInterfaces       CleanupCandidateList `json:"unexposedInterfaces"`
+	ModulesWithoutConsumers   CleanupCandidateList `json:"modulesWithoutConsumers"`
+	DatabasesWithoutConsumers CleanupCandidateList `json:"databasesWithoutConsumers"`
+}
+
+// CleanupCandidateList wraps a (truncated) list of identifiers together with the total number found
+type CleanupCandidateList struct {
+	TotalCount int      `json:"totalCount"`
+	IDs        []string `json:"ids"`
+}
+
+func newCleanupCandidateList(ids []string, limit int) CleanupCandidateList {
+	return CleanupCandidateList{
+		TotalCount: len(ids),
+		IDs:        ids[0:min(limit, len(ids))],
+	}
+}
+
+// OpenAPISpecification is the parsed OpenAPI specification of an interface, optionally with its raw content
+type OpenAPISpecification struct {
+	InterfaceID       string           `json:"interfaceID"`
+	SpecificationPath string           `json:"specificationPath"`
+	Title             string           `json:"title,omitempty"`
+	Version           string           `json:"version,omitempty"`
+	Operations        []spec.Operation `json:"operations"`
+	Raw               string           `json:"raw,omitempty"`
+}
+
+// RPLSpecification is the parsed RPL specification of an interface, optionally with its raw content
+type RPLSpecification struct {
+	InterfaceID       string            `json:"interfaceID"`
+	SpecificationPath string            `json:"specificationPath"`
+	Methods           []spec.RPLMethod  `json:"methods"`
+	Messages          []spec.RPLMessage `json:"messages"`
+	Raw               string            `json:"raw,omitempty"`
+}
+
+// OpenAPICompatibility lists the differences between the OpenAPI specification of an interface in the baseline and the current catalog
+type OpenAPICompatibility struct {
+	InterfaceID          string          `json:"interfaceID"`
+	OldSpecificationPath string          `json:"oldSpecificationPath"`
+	NewSpecificationPath string          `json:"newSpecificationPath"`
+	BreakingCount        int             `json:"breakingCount"`
+	Changes              []OpenAPIChange `json:"changes"`
+}
+
+// OpenAPIChange is a difference in an OpenAPI specification with the consuming modules affected when it is breaking
+type OpenAPIChange struct {
+	spec.Change
+	AffectedConsumers []string `json:"affectedConsumers,omitempty"`
 }
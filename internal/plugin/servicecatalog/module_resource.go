@@ -11,6 +11,7 @@ import (
 )
 
 // NewModulesResource returns the MCP resource contract and handler for modules configuration.
+// The resource contains the first page of modules.
 func (h *mcpHandler) modulesResource() server.ServerResource {
 	return server.ServerResource{
 		Resource: mcp.NewResource(
@@ -18,20 +19,45 @@ func (h *mcpHandler) modulesResource() server.ServerResource {
 			"List of modules in the catalog",
 			mcp.WithMIMEType("application/json"),
 		),
-		Handler: func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
-			// call business logic
-			modules, err := h.repo.ListModules(ctx, "")
-			if err != nil {
-				return nil, fmt.Errorf("error listing modules: %s", err)
-			}
-
-			return []mcp.ResourceContents{
-				mcp.TextResourceContents{
-					URI:      request.Params.URI,
-					MIMEType: "application/json",
-					Text:     resp.Success(ctx, modules),
-				},
-			}, nil
-		},
+		Handler: h.readModulesResource,
+	}
+}
+
+// NewModulesResourceTemplate returns the MCP resource template contract and handler for paging through the modules.
+func (h *mcpHandler) modulesResourceTemplate() server.ServerResourceTemplate {
+	return server.ServerResourceTemplate{
+		Template: mcp.NewResourceTemplate(
+			"catalog://modules{?page_size,cursor}",
+			"Page of modules in the catalog",
+			mcp.WithTemplateDescription("Use the next_cursor of the previous page as cursor to get the next page of modules"),
+			mcp.WithTemplateMIMEType("application/json"),
+		),
+		Handler: h.readModulesResource,
+	}
+}
+
+func (h *mcpHandler) readModulesResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	// extract params
+	page, err := resp.PageFromArguments(request.Params.Arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	// call business logic
+	modules, err := h.repo.ListModules(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("error listing modules: %s", err)
 	}
+
+	modules, nextCursor := resp.Paginate(modules, page)
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: "application/json",
+			Text: resp.Success(ctx, ModuleList{
+				Modules:    modules,
+				NextCursor: nextCursor,
+			}),
+		},
+	}, nil
 }
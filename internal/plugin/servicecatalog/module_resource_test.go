@@ -0,0 +1,75 @@
+package servicecatalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+func TestModulesResource_FirstPage(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListModules(gomock.Any(), "").Return([]repo.Module{{ModuleID: "module1"}, {ModuleID: "module2"}}, nil)
+
+	resource := NewMCPHandler(repository, nil).modulesResource()
+
+	// When
+	request := mcp.ReadResourceRequest{}
+	request.Params.URI = "catalog://modules"
+	contents, err := resource.Handler(context.Background(), request)
+
+	// Then
+	assert.NoError(t, err)
+	text := contents[0].(mcp.TextResourceContents).Text
+	assert.Contains(t, text, `"moduleID": "module1"`)
+	assert.Contains(t, text, `"moduleID": "module2"`)
+	assert.NotContains(t, text, "next_cursor")
+}
+
+func TestModulesResourceTemplate_Paged(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().ListModules(gomock.Any(), "").Return([]repo.Module{{ModuleID: "module1"}, {ModuleID: "module2"}}, nil)
+
+	template := NewMCPHandler(repository, nil).modulesResourceTemplate()
+
+	// When
+	request := mcp.ReadResourceRequest{}
+	request.Params.URI = "catalog://modules?page_size=1"
+	request.Params.Arguments = map[string]any{"page_size": []string{"1"}}
+	contents, err := template.Handler(context.Background(), request)
+
+	// Then
+	assert.NoError(t, err)
+	text := contents[0].(mcp.TextResourceContents).Text
+	assert.Contains(t, text, `"moduleID": "module1"`)
+	assert.NotContains(t, text, `"moduleID": "module2"`)
+	assert.Contains(t, text, `"next_cursor": "`)
+}
+
+func TestModulesResourceTemplate_InvalidPageSize(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	template := NewMCPHandler(repo.NewMockCataloger(ctrl), nil).modulesResourceTemplate()
+
+	// When
+	request := mcp.ReadResourceRequest{}
+	request.Params.Arguments = map[string]any{"page_size": []string{"0"}}
+	_, err := template.Handler(context.Background(), request)
+
+	// Then
+	assert.ErrorContains(t, err, "invalid page_size 0")
+}
@@ -0,0 +1,78 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+const (
+	defaultQueryRows = 100
+	maxQueryRows     = 1000
+	queryTimeout     = 5 * time.Second
+)
+
+// NewQueryCatalogTool returns the MCP tool definition and its handler for running a read-only SQL query on the catalog database.
+func (h *mcpHandler) queryCatalogTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"query_catalog",
+			mcp.WithDescription("Runs a single read-only SQL SELECT statement (SQLite dialect) on the service-catalog database and returns the columns and rows. "+
+				"Read the catalog://schema resource for the tables and columns. "+
+				fmt.Sprintf("Only use this when no other tool can answer the question. Queries are aborted after %s.", queryTimeout)),
+			mcp.WithString("query", mcp.Required(), mcp.Description("A single SELECT (or WITH ... SELECT) statement, without comments")),
+			mcp.WithNumber("max_rows", mcp.Description(fmt.Sprintf("Maximum number of rows to return (default %d, max %d).", defaultQueryRows, maxQueryRows))),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[repo.QueryResult](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			query, err := request.RequireString("query")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing query",
+						"query",
+						"Use a single SELECT statement")), nil
+			}
+			maxRows := request.GetInt("max_rows", defaultQueryRows)
+			if maxRows < 1 || maxRows > maxQueryRows {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid max_rows %d", maxRows),
+						"max_rows",
+						fmt.Sprintf("Use a max_rows between 1 and %d", maxQueryRows))), nil
+			}
+
+			// call business logic
+			queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+			defer cancel()
+			result, err := h.repo.QueryCatalog(queryCtx, query, maxRows)
+			if err != nil {
+				if errors.Is(err, repo.ErrInvalidQuery) {
+					return mcp.NewToolResultError(
+						resp.InvalidInput(ctx, err.Error(),
+							"query",
+							"Use a single SELECT statement on the tables of the catalog://schema resource")), nil
+				}
+				if errors.Is(err, context.DeadlineExceeded) {
+					return mcp.NewToolResultError(
+						resp.InvalidInput(ctx, fmt.Sprintf("Query took longer than %s", queryTimeout),
+							"query",
+							"Use a simpler query, for example with fewer joins or a more selective WHERE clause")), nil
+				}
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error querying catalog: %s", err))), nil
+			}
+
+			return mcp.NewToolResultJSON[repo.QueryResult](result)
+		},
+	}
+}
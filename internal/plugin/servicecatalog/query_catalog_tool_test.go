@@ -0,0 +1,133 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+func TestQueryCatalogTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().QueryCatalog(gomock.Any(), "SELECT module_id, team FROM module", 100).Return(repo.QueryResult{
+		Columns:  []string{"module_id", "team"},
+		Rows:     [][]any{{"module1", "team1"}, {"module2", "team2"}},
+		RowCount: 2,
+	}, nil)
+
+	tool := NewMCPHandler(repository, nil).queryCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("query_catalog", map[string]interface{}{
+		"query": "SELECT module_id, team FROM module",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Equal(t, `{"columns":["module_id","team"],"rows":[["module1","team1"],["module2","team2"]],"rowCount":2,"truncated":false}`, textResult.Text)
+}
+
+func TestQueryCatalogTool_InvalidQuery(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().QueryCatalog(gomock.Any(), "DELETE FROM module", 10).Return(repo.QueryResult{}, fmt.Errorf("%w: only SELECT statements are allowed", repo.ErrInvalidQuery))
+
+	tool := NewMCPHandler(repository, nil).queryCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("query_catalog", map[string]interface{}{
+		"query":    "DELETE FROM module",
+		"max_rows": 10,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "only SELECT statements are allowed")
+}
+
+func TestQueryCatalogTool_Timeout(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().QueryCatalog(gomock.Any(), "SELECT * FROM module, interface", 100).Return(repo.QueryResult{}, fmt.Errorf("query aborted: %w", context.DeadlineExceeded))
+
+	tool := NewMCPHandler(repository, nil).queryCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("query_catalog", map[string]interface{}{
+		"query": "SELECT * FROM module, interface",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Query took longer than 5s")
+}
+
+func TestQueryCatalogTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().QueryCatalog(gomock.Any(), "SELECT * FROM module", 100).Return(repo.QueryResult{}, errors.New("db error"))
+
+	tool := NewMCPHandler(repository, nil).queryCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("query_catalog", map[string]interface{}{
+		"query": "SELECT * FROM module",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "error querying catalog: db error")
+}
+
+func TestQueryCatalogTool_MissingQuery(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil).queryCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("query_catalog", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Missing query")
+}
+
+func TestQueryCatalogTool_InvalidMaxRows(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil).queryCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("query_catalog", map[string]interface{}{
+		"query":    "SELECT * FROM module",
+		"max_rows": 5000,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Invalid max_rows 5000")
+}
@@ -3,7 +3,10 @@ package repo
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
 )
 
 // Cataloger defines the interface for interacting with the service catalog repository.
@@ -15,21 +18,41 @@ type Cataloger interface {
 	ListDatabases(ctx context.Context) ([]string, error)
 	ListTeams(ctx context.Context) ([]string, error)
 	ListModules(ctx context.Context, keyword string) ([]Module, error)
-	ListModulesByCompexity(ctx context.Context, limit int) ([]Module, error)
+	FilterModules(ctx context.Context, filter ModuleFilter) ([]Module, error)
+	ListModulesByCompexity(ctx context.Context, limit int, profile string) ([]Module, bool, error)
+	ListComplexityProfiles(ctx context.Context) ([]complexity.Profile, error)
+	GetComplexityBreakdown(ctx context.Context, id string, profile string) (ComplexityBreakdown, bool, error)
 	ListModulesOfTeam(ctx context.Context, id string) ([]string, bool, error)
+	GetTeamOnID(ctx context.Context, id string) (Team, bool, error)
 	GetModuleOnID(ctx context.Context, id string) (Module, bool, error)
 	ListInterfaces(ctx context.Context, keyword string) ([]Interface, error)
-	ListInterfacesByComplexity(ctx context.Context, limit int) ([]Interface, error)
+	ListInterfaceMetrics(ctx context.Context) ([]Interface, error)
+	GetInterfaceComplexityProfile(ctx context.Context) (complexity.Profile, error)
 	GetInterfaceOnID(ctx context.Context, id string) (Interface, bool, error)
 	ListInterfaceConsumers(ctx context.Context, id string) ([]string, bool, error)
 	ListDatabaseConsumers(ctx context.Context, id string) ([]string, bool, error)
 	ListFlows(ctx context.Context) ([]string, error)
 	ListMethods(ctx context.Context) ([]string, error)
 	ListParticpantsOfFlow(ctx context.Context, id string) ([]string, bool, error)
+	GetFlowOnID(ctx context.Context, id string) (Flow, bool, error)
 	ListKinds(ctx context.Context) ([]string, error)
 	ListModulesWithKind(ctx context.Context, id string) ([]string, bool, error)
 	GetGradleDependenciesOfModule(ctx context.Context, id string) ([]string, bool, error)
 	ListConsumersOfGradleModule(ctx context.Context, id string) ([]string, bool, error)
+	GetDependencyGraph(ctx context.Context, id string, direction Direction, depth int) (DependencyGraph, bool, error)
+	GetImpactAnalysis(ctx context.Context, subject ImpactSubject, id string, depth int) (ImpactAnalysis, bool, error)
+	ListShortestPaths(ctx context.Context, fromID, toID string, limit int) ([]DependencyPath, error)
+	ListDependencyCycles(ctx context.Context) ([]DependencyCycle, error)
+	ListDependencyEdgesBetween(ctx context.Context, moduleIDs []string) ([]DependencyEdge, error)
+	GetGradleClosure(ctx context.Context, id string, direction Direction) (GradleClosure, bool, error)
+	ListRelationMembers(ctx context.Context, relation Relation) ([]RelationMember, error)
+	ListJobs(ctx context.Context, keyword string) ([]string, error)
+	GetJobOnID(ctx context.Context, id string) (Job, bool, error)
+	ListTeamDependencies(ctx context.Context, id string) (TeamDependencies, bool, error)
+	GetHygieneReport(ctx context.Context) (HygieneReport, error)
+	GetMethodOnID(ctx context.Context, id string) (Method, bool, error)
+	QueryCatalog(ctx context.Context, query string, maxRows int) (QueryResult, error)
+	GetSchema(ctx context.Context) ([]TableSchema, error)
 }
 
 // Module represents a software module in the catalog.
@@ -51,6 +74,8 @@ type Module struct {
 	JobCount           *int     `db:"job_count" json:"jobCount,omitempty"`
 	FlowCount          *int     `db:"flow_count" json:"flowCount,omitempty"`
 	DependencyCount    *int     `db:"gradle_count" json:"dependencyCount,omitempty"`
+	FanIn              *int     `db:"-" json:"fanIn,omitempty"`
+	FanOut             *int     `db:"-" json:"fanOut,omitempty"`
 	ApplicationKinds   []string `db:"-" json:"applicationKinds,omitempty"`
 	Teams              []string `db:"-" json:"teams,omitempty"`
 	Flows              []string `db:"-" json:"flows,omitempty"`
@@ -61,38 +86,37 @@ type Module struct {
 	Dependencies       []string `db:"-" json:"dependencies,omitempty"`
 }
 
-const (
-	lineCountWeight           float32 = 0.25
-	databaseCountWeight       float32 = 0.20
-	teamCountWeight           float32 = 0.15
-	exposedAPICountWeight     float32 = 0.15
-	consumedAPICountWeight    float32 = 0.15
-	jobCountWeight            float32 = 0.10
-	fileCountWeight           float32 = 0.10
-	flowCountWeight           float32 = 0.05
-	kindCountWeight           float32 = 0.05
-	complexityScoreMultiplier float32 = 100
-)
-
-// CalculateComplexityScore calculates the complexity score for a module.
-func (m Module) CalculateComplexityScore() float32 {
-	complexityScore := ((float32(m.LineCount/1000) * lineCountWeight) +
-		(valueOrZero(m.DatabaseCount) * databaseCountWeight) +
-		(valueOrZero(m.TeamCount) * teamCountWeight) +
-		(valueOrZero(m.ExposedAPICount) * exposedAPICountWeight) +
-		(valueOrZero(m.ConsumedAPICount) * consumedAPICountWeight) +
-		(valueOrZero(m.JobCount) * jobCountWeight) +
-		(valueOrZero(m.FlowCount) * flowCountWeight) +
-		(valueOrZero(m.KindCount) * kindCountWeight)) * complexityScoreMultiplier
-
-	return complexityScore
+// ComplexityValues returns the metrics of the module that complexity scoring profiles can use. Unknown counts are zero.
+func (m Module) ComplexityValues() complexity.Values {
+	return complexity.Values{
+		complexity.LineCount:        float64(m.LineCount),
+		complexity.FileCount:        float64(m.FileCount),
+		complexity.DatabaseCount:    valueOrZero(m.DatabaseCount),
+		complexity.TeamCount:        valueOrZero(m.TeamCount),
+		complexity.ExposedAPICount:  valueOrZero(m.ExposedAPICount),
+		complexity.ConsumedAPICount: valueOrZero(m.ConsumedAPICount),
+		complexity.JobCount:         valueOrZero(m.JobCount),
+		complexity.FlowCount:        valueOrZero(m.FlowCount),
+		complexity.KindCount:        valueOrZero(m.KindCount),
+		complexity.DependencyCount:  valueOrZero(m.DependencyCount),
+		complexity.FanIn:            valueOrZero(m.FanIn),
+		complexity.FanOut:           valueOrZero(m.FanOut),
+	}
 }
 
-func valueOrZero(value *int) float32 {
+func valueOrZero(value *int) float64 {
 	if value == nil {
 		return 0
 	}
-	return float32(*value)
+	return float64(*value)
+}
+
+// ComplexityBreakdown explains the complexity score of a module against all modules and the other modules of its team.
+type ComplexityBreakdown struct {
+	ModuleID        string `json:"moduleID"`
+	Team            string `json:"team,omitempty"`
+	TeamModuleCount int    `json:"teamModuleCount"`
+	complexity.Breakdown
 }
 
 func (m Module) String() string {
@@ -106,9 +130,281 @@ type Interface struct {
 	InterfaceID   string   `db:"interface_id" json:"interfaceID,omitempty"`
 	Description   string   `db:"description" json:"description,omitempty"`
 	Kind          string   `db:"kind" json:"kind,omitempty"`
-	OpenAPISpecs  *string  `db:"openapi_specification" json:"-"` // API can not deal with null returned for string
-	RPLSpecs      *string  `db:"rpl_specification" json:"-"`     // API can not deal with null returned for string
+	OpenAPISpecs  *string  `db:"openapi_specification" json:"openAPISpecification,omitempty"` // API can not deal with null returned for string
+	RPLSpecs      *string  `db:"rpl_specification" json:"rplSpecification,omitempty"`         // API can not deal with null returned for string
 	MethodCount   int      `db:"method_count" json:"methodCount,omitempty"`
 	Methods       []string `db:"-" json:"methods,omitempty"`
 	MethodBasedID string   `db:"method_based_interface_id" json:"methodBasedID,omitempty"`
+
+	Team               string `db:"team" json:"team,omitempty"`
+	ConsumerCount      *int   `db:"consumer_count" json:"consumerCount,omitempty"`
+	ConsumingTeamCount *int   `db:"consuming_team_count" json:"consumingTeamCount,omitempty"`
+}
+
+// ComplexityValues returns the metrics of the interface known to the catalog that complexity scoring profiles can use.
+// Metrics derived from the specification of the interface are not included.
+func (i Interface) ComplexityValues() complexity.Values {
+	return complexity.Values{
+		complexity.MethodCount:        float64(i.MethodCount),
+		complexity.ConsumerCount:      valueOrZero(i.ConsumerCount),
+		complexity.ConsumingTeamCount: valueOrZero(i.ConsumingTeamCount),
+	}
+}
+
+// Method represents a web-method together with the interfaces that contain it.
+type Method struct {
+	MethodID   string            `json:"methodID"`
+	Interfaces []MethodInterface `json:"interfaces"`
+}
+
+// MethodInterface is an interface containing a method, with the modules exposing and consuming that interface.
+// Consumption is only known at interface level, so consumers may not call this particular method.
+type MethodInterface struct {
+	InterfaceID string   `db:"interface_id" json:"interfaceID"`
+	ExposedBy   []string `db:"-" json:"exposedBy"`
+	ConsumedBy  []string `db:"-" json:"consumedBy"`
+}
+
+// Team represents a team with aggregated metrics of the modules it owns. Complexity is scored with the default profile.
+type Team struct {
+	TeamID                 string   `json:"teamID"`
+	Modules                []string `json:"modules"`
+	ModuleCount            int      `json:"moduleCount"`
+	LineCount              int      `json:"lineCount"`
+	FileCount              int      `json:"fileCount"`
+	TotalComplexityScore   float32  `json:"totalComplexityScore"`
+	AverageComplexityScore float32  `json:"averageComplexityScore"`
+	ExposedInterfaces      []string `json:"exposedInterfaces"`
+	ConsumedInterfaces     []string `json:"consumedInterfaces"`
+	Databases              []string `json:"databases"`
+	Flows                  []string `json:"flows"`
+	Jobs                   []string `json:"jobs"`
+}
+
+// ModuleFilter selects and orders modules. Empty fields and nil ranges do not restrict the selection.
+// Complexity is scored with the default profile.
+type ModuleFilter struct {
+	Keyword       string
+	Team          string
+	Kind          string
+	Flow          string
+	Database      string
+	MinLineCount  *int
+	MaxLineCount  *int
+	MinComplexity *float64
+	MaxComplexity *float64
+	SortBy        ModuleSortField
+	Descending    bool
+}
+
+// ModuleSortField is the field a list of modules is ordered on
+type ModuleSortField string
+
+const (
+	// SortOnModuleID orders modules alphabetically on their ID
+	SortOnModuleID ModuleSortField = "module_id"
+	// SortOnLineCount orders modules on their lines of code
+	SortOnLineCount ModuleSortField = "line_count"
+	// SortOnFileCount orders modules on their number of files
+	SortOnFileCount ModuleSortField = "file_count"
+	// SortOnComplexity orders modules on their complexity score
+	SortOnComplexity ModuleSortField = "complexity"
+)
+
+// ModuleSortFields lists the fields modules can be ordered on
+var ModuleSortFields = []ModuleSortField{SortOnModuleID, SortOnLineCount, SortOnFileCount, SortOnComplexity}
+
+// Job represents a scheduled (batch) job and the modules that run it.
+type Job struct {
+	JobID   string   `json:"jobID"`
+	Modules []string `json:"modules"`
+	Teams   []string `json:"teams"`
+}
+
+// Flow represents a business flow as a graph: the participating modules connected by the interfaces they consume from each other.
+type Flow struct {
+	FlowID       string            `json:"flowID"`
+	Participants []FlowParticipant `json:"participants"`
+	Edges        []DependencyEdge  `json:"edges"`
+	Teams        []string          `json:"teams"`
+	EntryPoints  []string          `json:"entryPoints"`
+}
+
+// FlowParticipant is a module participating in a flow together with its owning team.
+type FlowParticipant struct {
+	ModuleID string `json:"moduleID"`
+	Team     string `json:"team,omitempty"`
+}
+
+// Direction indicates which way the module dependency graph is traversed.
+type Direction string
+
+const (
+	// DirectionRoot marks the module the traversal started from
+	DirectionRoot Direction = "root"
+	// DirectionDependencies follows the interfaces a module consumes towards the modules exposing them
+	DirectionDependencies Direction = "dependencies"
+	// DirectionConsumers follows the interfaces a module exposes towards the modules consuming them
+	DirectionConsumers Direction = "consumers"
+	// DirectionBoth follows both dependencies and consumers
+	DirectionBoth Direction = "both"
+)
+
+// DependencyGraph represents the modules reachable from a root module via consumed and exposed interfaces.
+type DependencyGraph struct {
+	RootModuleID string           `json:"rootModuleID"`
+	Direction    Direction        `json:"direction"`
+	Depth        int              `json:"depth"`
+	Nodes        []DependencyNode `json:"nodes"`
+	Edges        []DependencyEdge `json:"edges"`
+}
+
+// DependencyNode is a module in a dependency graph together with its hop distance from the root module.
+type DependencyNode struct {
+	ModuleID  string    `json:"moduleID"`
+	Direction Direction `json:"direction"`
+	Distance  int       `json:"distance"`
+}
+
+// DependencyEdge represents a module consuming an interface that is exposed by another module.
+type DependencyEdge struct {
+	ConsumerModuleID string `db:"consumer_module_id" json:"consumerModuleID"`
+	InterfaceID      string `db:"interface_id" json:"interfaceID"`
+	ProviderModuleID string `db:"provider_module_id" json:"providerModuleID"`
+}
+
+// DependencyPath is a chain of modules where each module consumes an interface exposed by the next one.
+type DependencyPath struct {
+	Length int              `json:"length"`
+	Hops   []DependencyEdge `json:"hops"`
+}
+
+// DependencyCycle is a group of modules that (indirectly) consume each other's interfaces.
+type DependencyCycle struct {
+	Modules []string         `json:"modules"`
+	Edges   []DependencyEdge `json:"edges"`
+}
+
+// TeamDependencies aggregates the module interface graph to the level of owning teams.
+// For a single team ConsumesFrom and ConsumedBy are filled, otherwise Dependencies lists all team pairs.
+type TeamDependencies struct {
+	TeamID       string           `json:"teamID,omitempty"`
+	ConsumesFrom []TeamDependency `json:"consumesFrom,omitempty"`
+	ConsumedBy   []TeamDependency `json:"consumedBy,omitempty"`
+	Dependencies []TeamDependency `json:"dependencies,omitempty"`
+}
+
+// TeamDependency represents modules of one team consuming interfaces exposed by modules of another team.
+type TeamDependency struct {
+	ConsumerTeam string   `json:"consumerTeam"`
+	ProviderTeam string   `json:"providerTeam"`
+	EdgeCount    int      `json:"edgeCount"`
+	Interfaces   []string `json:"interfaces"`
+}
+
+// ImpactSubject indicates the kind of entity an impact analysis is performed for.
+type ImpactSubject string
+
+const (
+	// ImpactSubjectInterface analyses the impact of changing an interface
+	ImpactSubjectInterface ImpactSubject = "interface"
+	// ImpactSubjectDatabase analyses the impact of changing a database
+	ImpactSubjectDatabase ImpactSubject = "database"
+)
+
+// Relation identifies a relation between entities of the catalog and their members
+type Relation string
+
+const (
+	// RelationInterfaceMethods relates interfaces to their methods
+	RelationInterfaceMethods Relation = "interface-methods"
+	// RelationInterfaceConsumers relates interfaces to the modules consuming them
+	RelationInterfaceConsumers Relation = "interface-consumers"
+	// RelationDatabaseConsumers relates databases to the modules using them
+	RelationDatabaseConsumers Relation = "database-consumers"
+	// RelationFlowParticipants relates flows to the modules participating in them
+	RelationFlowParticipants Relation = "flow-participants"
+)
+
+// RelationMember links an entity to one of its members, like an interface to a module consuming it
+type RelationMember struct {
+	ID       string `db:"id"`
+	MemberID string `db:"member_id"`
+}
+
+// ImpactAnalysis lists the modules directly and transitively affected by a change of an interface or database.
+type ImpactAnalysis struct {
+	Subject         ImpactSubject       `json:"subject"`
+	SubjectID       string              `json:"subjectID"`
+	Depth           int                 `json:"depth"`
+	AffectedModules []AffectedModule    `json:"affectedModules"`
+	ByTeam          map[string][]string `json:"byTeam"`
+	ByFlow          map[string][]string `json:"byFlow"`
+	ByKind          map[string][]string `json:"byKind"`
+}
+
+// AffectedModule is a module impacted by a change. Distance 1 means the module uses the changed entity directly.
+type AffectedModule struct {
+	ModuleID string   `json:"moduleID"`
+	Distance int      `json:"distance"`
+	Team     string   `json:"team,omitempty"`
+	Flows    []string `json:"flows,omitempty"`
+	Kinds    []string `json:"kinds,omitempty"`
+}
+
+// GradleClosure lists all gradle modules a module transitively depends on, or all modules that transitively depend on it.
+type GradleClosure struct {
+	ModuleID  string               `json:"moduleID"`
+	Direction Direction            `json:"direction"`
+	Entries   []GradleClosureEntry `json:"entries"`
+	Diamonds  []GradleClosureEntry `json:"diamonds"`
+}
+
+// GradleClosureEntry is a gradle module in a closure. Via lists the modules in the closure it is reached from.
+// An entry that is reached via multiple modules is a diamond dependency.
+type GradleClosureEntry struct {
+	ModuleID string   `json:"moduleID"`
+	Depth    int      `json:"depth"`
+	Via      []string `json:"via"`
+}
+
+// HygieneReport lists catalog entities that nobody uses and are therefore candidates for cleanup.
+type HygieneReport struct {
+	UnconsumedInterfaces      []string `json:"unconsumedInterfaces"`
+	UnexposedInterfaces       []string `json:"unexposedInterfaces"`
+	ModulesWithoutConsumers   []string `json:"modulesWithoutConsumers"`
+	DatabasesWithoutConsumers []string `json:"databasesWithoutConsumers"`
+}
+
+// ErrInvalidQuery indicates that a query on the catalog is not a single valid SELECT statement
+var ErrInvalidQuery = errors.New("invalid query")
+
+// UnknownModuleError indicates which of the modules a query refers to does not exist
+type UnknownModuleError struct {
+	ModuleID string
+}
+
+func (e UnknownModuleError) Error() string {
+	return fmt.Sprintf("module %s not found", e.ModuleID)
+}
+
+// QueryResult holds the rows returned by a read-only query on the catalog
+type QueryResult struct {
+	Columns   []string `json:"columns"`
+	Rows      [][]any  `json:"rows"`
+	RowCount  int      `json:"rowCount"`
+	Truncated bool     `json:"truncated"`
+}
+
+// TableSchema describes a table or view of the catalog database
+type TableSchema struct {
+	Name    string         `db:"name" json:"name"`
+	Type    string         `db:"type" json:"type"`
+	Columns []ColumnSchema `db:"-" json:"columns"`
+}
+
+// ColumnSchema describes a column of a table or view of the catalog database
+type ColumnSchema struct {
+	Name string `db:"name" json:"name"`
+	Type string `db:"type" json:"type,omitempty"`
 }
@@ -0,0 +1,405 @@
+package repo
+
+import (
+	"math"
+	"sort"
+)
+
+// moduleGraph is an in-memory representation of the module-interface-module graph.
+type moduleGraph struct {
+	dependencies map[string][]DependencyEdge // keyed on consuming module
+	consumers    map[string][]DependencyEdge // keyed on providing module
+}
+
+func newModuleGraph(edges []DependencyEdge) moduleGraph {
+	g := moduleGraph{
+		dependencies: map[string][]DependencyEdge{},
+		consumers:    map[string][]DependencyEdge{},
+	}
+	for _, edge := range edges {
+		if edge.ConsumerModuleID == edge.ProviderModuleID {
+			// A module consuming its own interface does not add a dependency
+			continue
+		}
+		g.dependencies[edge.ConsumerModuleID] = append(g.dependencies[edge.ConsumerModuleID], edge)
+		g.consumers[edge.ProviderModuleID] = append(g.consumers[edge.ProviderModuleID], edge)
+	}
+	return g
+}
+
+// traverse walks the graph breadth-first from the root module up to depth hops in the given direction.
+func (g moduleGraph) traverse(rootID string, direction Direction, depth int) ([]DependencyNode, []DependencyEdge) {
+	nodes := []DependencyNode{{ModuleID: rootID, Direction: DirectionRoot, Distance: 0}}
+	edges := []DependencyEdge{}
+	seenEdges := map[DependencyEdge]bool{}
+
+	for _, dir := range []Direction{DirectionDependencies, DirectionConsumers} {
+		if direction != dir && direction != DirectionBoth {
+			continue
+		}
+
+		distances := map[string]int{rootID: 0}
+		frontier := []string{rootID}
+		for hop := 1; hop <= depth && len(frontier) > 0; hop++ {
+			next := []string{}
+			for _, moduleID := range frontier {
+				for _, edge := range g.neighbours(moduleID, dir) {
+					if !seenEdges[edge] {
+						seenEdges[edge] = true
+						edges = append(edges, edge)
+					}
+					other := edge.other(moduleID)
+					if _, found := distances[other]; found {
+						continue
+					}
+					distances[other] = hop
+					next = append(next, other)
+					nodes = append(nodes, DependencyNode{ModuleID: other, Direction: dir, Distance: hop})
+				}
+			}
+			frontier = next
+		}
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].Distance != nodes[j].Distance {
+			return nodes[i].Distance < nodes[j].Distance
+		}
+		if nodes[i].Direction != nodes[j].Direction {
+			return nodes[i].Direction < nodes[j].Direction
+		}
+		return nodes[i].ModuleID < nodes[j].ModuleID
+	})
+	sortEdges(edges)
+
+	return nodes, edges
+}
+
+// reach walks the graph breadth-first from modules with a known distance and returns the distance of every module reached within depth hops.
+func (g moduleGraph) reach(starts map[string]int, direction Direction, depth int) map[string]int {
+	distances := map[string]int{}
+	frontier := []string{}
+	for moduleID, distance := range starts {
+		distances[moduleID] = distance
+		frontier = append(frontier, moduleID)
+	}
+	sort.Strings(frontier)
+
+	for len(frontier) > 0 {
+		next := []string{}
+		for _, moduleID := range frontier {
+			hop := distances[moduleID] + 1
+			if hop > depth {
+				continue
+			}
+			for _, edge := range g.neighbours(moduleID, direction) {
+				other := edge.other(moduleID)
+				if _, found := distances[other]; found {
+					continue
+				}
+				distances[other] = hop
+				next = append(next, other)
+			}
+		}
+		frontier = next
+	}
+	return distances
+}
+
+// shortestPaths returns up to limit shortest chains of consumed interfaces leading from one module to another.
+func (g moduleGraph) shortestPaths(fromID, toID string, limit int) [][]DependencyEdge {
+	if fromID == toID {
+		return [][]DependencyEdge{}
+	}
+
+	// Breadth-first search that remembers all edges arriving via a shortest route
+	distances := map[string]int{fromID: 0}
+	predecessors := map[string][]DependencyEdge{}
+	frontier := []string{fromID}
+	for len(frontier) > 0 {
+		if _, found := distances[toID]; found {
+			break
+		}
+		next := []string{}
+		for _, moduleID := range frontier {
+			for _, edge := range g.dependencies[moduleID] {
+				other := edge.ProviderModuleID
+				distance, found := distances[other]
+				if !found {
+					distances[other] = distances[moduleID] + 1
+					next = append(next, other)
+				} else if distance != distances[moduleID]+1 {
+					continue
+				}
+				predecessors[other] = append(predecessors[other], edge)
+			}
+		}
+		frontier = next
+	}
+
+	paths := [][]DependencyEdge{}
+	if _, found := distances[toID]; !found {
+		return paths
+	}
+
+	// Walk back from the target to enumerate the shortest paths
+	var walk func(moduleID string, suffix []DependencyEdge)
+	walk = func(moduleID string, suffix []DependencyEdge) {
+		if len(paths) >= limit {
+			return
+		}
+		if moduleID == fromID {
+			path := make([]DependencyEdge, len(suffix))
+			for i, edge := range suffix {
+				path[len(suffix)-1-i] = edge
+			}
+			paths = append(paths, path)
+			return
+		}
+		for _, edge := range predecessors[moduleID] {
+			walk(edge.ConsumerModuleID, append(suffix, edge))
+		}
+	}
+	walk(toID, []DependencyEdge{})
+
+	return paths
+}
+
+// stronglyConnectedComponents returns the groups of modules that can reach each other, using Tarjan's algorithm.
+// Only components with more than one module are returned, because those form dependency cycles.
+func (g moduleGraph) stronglyConnectedComponents() [][]string {
+	moduleIDs := []string{}
+	for moduleID := range g.dependencies {
+		moduleIDs = append(moduleIDs, moduleID)
+	}
+	sort.Strings(moduleIDs)
+
+	index := 0
+	indices := map[string]int{}
+	lowLinks := map[string]int{}
+	onStack := map[string]bool{}
+	stack := []string{}
+	components := [][]string{}
+
+	var connect func(moduleID string)
+	connect = func(moduleID string) {
+		indices[moduleID] = index
+		lowLinks[moduleID] = index
+		index++
+		stack = append(stack, moduleID)
+		onStack[moduleID] = true
+
+		for _, edge := range g.dependencies[moduleID] {
+			other := edge.ProviderModuleID
+			if _, visited := indices[other]; !visited {
+				connect(other)
+				lowLinks[moduleID] = min(lowLinks[moduleID], lowLinks[other])
+			} else if onStack[other] {
+				lowLinks[moduleID] = min(lowLinks[moduleID], indices[other])
+			}
+		}
+
+		if lowLinks[moduleID] == indices[moduleID] {
+			component := []string{}
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				component = append(component, top)
+				if top == moduleID {
+					break
+				}
+			}
+			if len(component) > 1 {
+				sort.Strings(component)
+				components = append(components, component)
+			}
+		}
+	}
+
+	for _, moduleID := range moduleIDs {
+		if _, visited := indices[moduleID]; !visited {
+			connect(moduleID)
+		}
+	}
+
+	sort.Slice(components, func(i, j int) bool {
+		if len(components[i]) != len(components[j]) {
+			return len(components[i]) > len(components[j])
+		}
+		return components[i][0] < components[j][0]
+	})
+
+	return components
+}
+
+// edgesWithin returns the edges that connect modules of the given set.
+func (g moduleGraph) edgesWithin(moduleIDs []string) []DependencyEdge {
+	members := map[string]bool{}
+	for _, moduleID := range moduleIDs {
+		members[moduleID] = true
+	}
+
+	edges := []DependencyEdge{}
+	for _, moduleID := range moduleIDs {
+		for _, edge := range g.dependencies[moduleID] {
+			if members[edge.ProviderModuleID] {
+				edges = append(edges, edge)
+			}
+		}
+	}
+	sortEdges(edges)
+
+	return edges
+}
+
+// closure returns every module transitively reachable from the root module, with its depth and the modules it is reached from.
+func (g moduleGraph) closure(rootID string, direction Direction) []GradleClosureEntry {
+	distances := g.reach(map[string]int{rootID: 0}, direction, math.MaxInt)
+
+	reverse := DirectionConsumers
+	if direction == DirectionConsumers {
+		reverse = DirectionDependencies
+	}
+
+	entries := []GradleClosureEntry{}
+	for moduleID, distance := range distances {
+		if moduleID == rootID {
+			continue
+		}
+		parents := map[string]bool{}
+		for _, edge := range g.neighbours(moduleID, reverse) {
+			other := edge.other(moduleID)
+			if _, found := distances[other]; found {
+				parents[other] = true
+			}
+		}
+		entry := GradleClosureEntry{
+			ModuleID: moduleID,
+			Depth:    distance,
+			Via:      []string{},
+		}
+		for parent := range parents {
+			entry.Via = append(entry.Via, parent)
+		}
+		sort.Strings(entry.Via)
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Depth != entries[j].Depth {
+			return entries[i].Depth < entries[j].Depth
+		}
+		return entries[i].ModuleID < entries[j].ModuleID
+	})
+
+	return entries
+}
+
+// entryPoints returns the modules of the given set that no other module of the set consumes an interface of.
+func entryPoints(moduleIDs []string, edges []DependencyEdge) []string {
+	consumed := map[string]bool{}
+	for _, edge := range edges {
+		if edge.ConsumerModuleID != edge.ProviderModuleID {
+			consumed[edge.ProviderModuleID] = true
+		}
+	}
+
+	entries := []string{}
+	for _, moduleID := range moduleIDs {
+		if !consumed[moduleID] {
+			entries = append(entries, moduleID)
+		}
+	}
+	sort.Strings(entries)
+
+	return entries
+}
+
+// teamDependencies aggregates module dependencies into dependencies between the teams owning the modules.
+// Dependencies between modules of the same team are left out.
+func teamDependencies(edges []DependencyEdge, teams map[string]string) []TeamDependency {
+	type teamPair struct {
+		consumer string
+		provider string
+	}
+
+	aggregated := map[teamPair]*TeamDependency{}
+	interfaces := map[teamPair]map[string]bool{}
+	for _, edge := range edges {
+		pair := teamPair{consumer: teams[edge.ConsumerModuleID], provider: teams[edge.ProviderModuleID]}
+		if pair.consumer == "" || pair.provider == "" || pair.consumer == pair.provider {
+			continue
+		}
+		if _, found := aggregated[pair]; !found {
+			aggregated[pair] = &TeamDependency{ConsumerTeam: pair.consumer, ProviderTeam: pair.provider, Interfaces: []string{}}
+			interfaces[pair] = map[string]bool{}
+		}
+		aggregated[pair].EdgeCount++
+		if !interfaces[pair][edge.InterfaceID] {
+			interfaces[pair][edge.InterfaceID] = true
+			aggregated[pair].Interfaces = append(aggregated[pair].Interfaces, edge.InterfaceID)
+		}
+	}
+
+	dependencies := []TeamDependency{}
+	for _, dependency := range aggregated {
+		sort.Strings(dependency.Interfaces)
+		dependencies = append(dependencies, *dependency)
+	}
+	sort.Slice(dependencies, func(i, j int) bool {
+		if dependencies[i].EdgeCount != dependencies[j].EdgeCount {
+			return dependencies[i].EdgeCount > dependencies[j].EdgeCount
+		}
+		if dependencies[i].ConsumerTeam != dependencies[j].ConsumerTeam {
+			return dependencies[i].ConsumerTeam < dependencies[j].ConsumerTeam
+		}
+		return dependencies[i].ProviderTeam < dependencies[j].ProviderTeam
+	})
+
+	return dependencies
+}
+
+// fanIn returns the number of distinct modules consuming an interface of the module.
+func (g moduleGraph) fanIn(moduleID string) int {
+	return countDistinct(g.consumers[moduleID], moduleID)
+}
+
+// fanOut returns the number of distinct modules the module consumes an interface of.
+func (g moduleGraph) fanOut(moduleID string) int {
+	return countDistinct(g.dependencies[moduleID], moduleID)
+}
+
+func countDistinct(edges []DependencyEdge, moduleID string) int {
+	others := map[string]bool{}
+	for _, edge := range edges {
+		others[edge.other(moduleID)] = true
+	}
+	return len(others)
+}
+
+func (g moduleGraph) neighbours(moduleID string, direction Direction) []DependencyEdge {
+	if direction == DirectionConsumers {
+		return g.consumers[moduleID]
+	}
+	return g.dependencies[moduleID]
+}
+
+func (e DependencyEdge) other(moduleID string) string {
+	if e.ConsumerModuleID == moduleID {
+		return e.ProviderModuleID
+	}
+	return e.ConsumerModuleID
+}
+
+func sortEdges(edges []DependencyEdge) {
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].ConsumerModuleID != edges[j].ConsumerModuleID {
+			return edges[i].ConsumerModuleID < edges[j].ConsumerModuleID
+		}
+		if edges[i].ProviderModuleID != edges[j].ProviderModuleID {
+			return edges[i].ProviderModuleID < edges[j].ProviderModuleID
+		}
+		return edges[i].InterfaceID < edges[j].InterfaceID
+	})
+}
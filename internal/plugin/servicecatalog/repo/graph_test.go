@@ -0,0 +1,178 @@
+package repo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testGraph() moduleGraph {
+	// a -> b -> c -> a, d -> a, a -> a
+	return newModuleGraph([]DependencyEdge{
+		{ConsumerModuleID: "a", InterfaceID: "IB", ProviderModuleID: "b"},
+		{ConsumerModuleID: "b", InterfaceID: "IC", ProviderModuleID: "c"},
+		{ConsumerModuleID: "c", InterfaceID: "IA", ProviderModuleID: "a"},
+		{ConsumerModuleID: "d", InterfaceID: "IA", ProviderModuleID: "a"},
+		{ConsumerModuleID: "a", InterfaceID: "IA", ProviderModuleID: "a"},
+	})
+}
+
+func TestTraverseDependencies(t *testing.T) {
+	nodes, edges := testGraph().traverse("a", DirectionDependencies, 1)
+	assert.Equal(t, []DependencyNode{
+		{ModuleID: "a", Direction: DirectionRoot, Distance: 0},
+		{ModuleID: "b", Direction: DirectionDependencies, Distance: 1},
+	}, nodes)
+	assert.Equal(t, []DependencyEdge{
+		{ConsumerModuleID: "a", InterfaceID: "IB", ProviderModuleID: "b"},
+	}, edges)
+}
+
+func TestTraverseConsumers(t *testing.T) {
+	nodes, edges := testGraph().traverse("a", DirectionConsumers, 5)
+	assert.Equal(t, []DependencyNode{
+		{ModuleID: "a", Direction: DirectionRoot, Distance: 0},
+		{ModuleID: "c", Direction: DirectionConsumers, Distance: 1},
+		{ModuleID: "d", Direction: DirectionConsumers, Distance: 1},
+		{ModuleID: "b", Direction: DirectionConsumers, Distance: 2},
+	}, nodes)
+	assert.Len(t, edges, 4)
+}
+
+func TestTraverseBoth(t *testing.T) {
+	nodes, edges := testGraph().traverse("a", DirectionBoth, 2)
+	assert.Equal(t, []DependencyNode{
+		{ModuleID: "a", Direction: DirectionRoot, Distance: 0},
+		{ModuleID: "c", Direction: DirectionConsumers, Distance: 1},
+		{ModuleID: "d", Direction: DirectionConsumers, Distance: 1},
+		{ModuleID: "b", Direction: DirectionDependencies, Distance: 1},
+		{ModuleID: "b", Direction: DirectionConsumers, Distance: 2},
+		{ModuleID: "c", Direction: DirectionDependencies, Distance: 2},
+	}, nodes)
+	assert.Len(t, edges, 4)
+}
+
+func TestReachConsumers(t *testing.T) {
+	distances := testGraph().reach(map[string]int{"c": 1}, DirectionConsumers, 3)
+	assert.Equal(t, map[string]int{"c": 1, "b": 2, "a": 3}, distances)
+}
+
+func TestShortestPaths(t *testing.T) {
+	g := newModuleGraph([]DependencyEdge{
+		{ConsumerModuleID: "a", InterfaceID: "IB", ProviderModuleID: "b"},
+		{ConsumerModuleID: "a", InterfaceID: "IC", ProviderModuleID: "c"},
+		{ConsumerModuleID: "b", InterfaceID: "ID", ProviderModuleID: "d"},
+		{ConsumerModuleID: "c", InterfaceID: "ID", ProviderModuleID: "d"},
+		{ConsumerModuleID: "d", InterfaceID: "IE", ProviderModuleID: "e"},
+		{ConsumerModuleID: "a", InterfaceID: "IF", ProviderModuleID: "f"},
+		{ConsumerModuleID: "f", InterfaceID: "IG", ProviderModuleID: "g"},
+		{ConsumerModuleID: "g", InterfaceID: "IE", ProviderModuleID: "e"},
+		{ConsumerModuleID: "g", InterfaceID: "IX", ProviderModuleID: "x"},
+	})
+
+	paths := g.shortestPaths("a", "d", 10)
+	assert.Equal(t, [][]DependencyEdge{
+		{
+			{ConsumerModuleID: "a", InterfaceID: "IB", ProviderModuleID: "b"},
+			{ConsumerModuleID: "b", InterfaceID: "ID", ProviderModuleID: "d"},
+		},
+		{
+			{ConsumerModuleID: "a", InterfaceID: "IC", ProviderModuleID: "c"},
+			{ConsumerModuleID: "c", InterfaceID: "ID", ProviderModuleID: "d"},
+		},
+	}, paths)
+
+	assert.Len(t, g.shortestPaths("a", "e", 10), 3)
+	assert.Len(t, g.shortestPaths("a", "e", 1), 1)
+	assert.Empty(t, g.shortestPaths("e", "a", 10))
+	assert.Empty(t, g.shortestPaths("a", "a", 10))
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	g := newModuleGraph([]DependencyEdge{
+		{ConsumerModuleID: "a", InterfaceID: "IB", ProviderModuleID: "b"},
+		{ConsumerModuleID: "b", InterfaceID: "IC", ProviderModuleID: "c"},
+		{ConsumerModuleID: "c", InterfaceID: "IA", ProviderModuleID: "a"},
+		{ConsumerModuleID: "c", InterfaceID: "ID", ProviderModuleID: "d"},
+		{ConsumerModuleID: "x", InterfaceID: "IY", ProviderModuleID: "y"},
+		{ConsumerModuleID: "y", InterfaceID: "IX", ProviderModuleID: "x"},
+		{ConsumerModuleID: "z", InterfaceID: "IZ", ProviderModuleID: "z"},
+	})
+
+	components := g.stronglyConnectedComponents()
+	assert.Equal(t, [][]string{{"a", "b", "c"}, {"x", "y"}}, components)
+
+	assert.Equal(t, []DependencyEdge{
+		{ConsumerModuleID: "a", InterfaceID: "IB", ProviderModuleID: "b"},
+		{ConsumerModuleID: "b", InterfaceID: "IC", ProviderModuleID: "c"},
+		{ConsumerModuleID: "c", InterfaceID: "IA", ProviderModuleID: "a"},
+	}, g.edgesWithin(components[0]))
+}
+
+func TestClosure(t *testing.T) {
+	g := newModuleGraph([]DependencyEdge{
+		{ConsumerModuleID: "app", ProviderModuleID: "lib1"},
+		{ConsumerModuleID: "app", ProviderModuleID: "lib2"},
+		{ConsumerModuleID: "lib1", ProviderModuleID: "common"},
+		{ConsumerModuleID: "lib2", ProviderModuleID: "common"},
+		{ConsumerModuleID: "common", ProviderModuleID: "util"},
+	})
+
+	assert.Equal(t, []GradleClosureEntry{
+		{ModuleID: "lib1", Depth: 1, Via: []string{"app"}},
+		{ModuleID: "lib2", Depth: 1, Via: []string{"app"}},
+		{ModuleID: "common", Depth: 2, Via: []string{"lib1", "lib2"}},
+		{ModuleID: "util", Depth: 3, Via: []string{"common"}},
+	}, g.closure("app", DirectionDependencies))
+
+	assert.Equal(t, []GradleClosureEntry{
+		{ModuleID: "lib1", Depth: 1, Via: []string{"common"}},
+		{ModuleID: "lib2", Depth: 1, Via: []string{"common"}},
+		{ModuleID: "app", Depth: 2, Via: []string{"lib1", "lib2"}},
+	}, g.closure("common", DirectionConsumers))
+}
+
+func TestFanInFanOut(t *testing.T) {
+	g := newModuleGraph([]DependencyEdge{
+		{ConsumerModuleID: "a", InterfaceID: "IB1", ProviderModuleID: "b"},
+		{ConsumerModuleID: "a", InterfaceID: "IB2", ProviderModuleID: "b"},
+		{ConsumerModuleID: "c", InterfaceID: "IB1", ProviderModuleID: "b"},
+		{ConsumerModuleID: "b", InterfaceID: "IB1", ProviderModuleID: "b"},
+		{ConsumerModuleID: "b", InterfaceID: "ID", ProviderModuleID: "d"},
+	})
+
+	assert.Equal(t, 2, g.fanIn("b"))
+	assert.Equal(t, 1, g.fanOut("b"))
+	assert.Equal(t, 0, g.fanIn("a"))
+	assert.Equal(t, 1, g.fanOut("a"))
+	assert.Equal(t, 0, g.fanOut("unknown"))
+}
+
+func TestEntryPoints(t *testing.T) {
+	edges := []DependencyEdge{
+		{ConsumerModuleID: "web", InterfaceID: "IA", ProviderModuleID: "api"},
+		{ConsumerModuleID: "api", InterfaceID: "IS", ProviderModuleID: "storage"},
+		{ConsumerModuleID: "batch", InterfaceID: "IS", ProviderModuleID: "storage"},
+		{ConsumerModuleID: "storage", InterfaceID: "IS", ProviderModuleID: "storage"},
+	}
+
+	assert.Equal(t, []string{"batch", "web"}, entryPoints([]string{"web", "api", "storage", "batch"}, edges))
+	assert.Equal(t, []string{"lonely"}, entryPoints([]string{"lonely"}, []DependencyEdge{}))
+}
+
+func TestTeamDependencies(t *testing.T) {
+	edges := []DependencyEdge{
+		{ConsumerModuleID: "a1", InterfaceID: "IB1", ProviderModuleID: "b1"},
+		{ConsumerModuleID: "a2", InterfaceID: "IB1", ProviderModuleID: "b1"},
+		{ConsumerModuleID: "a1", InterfaceID: "IB2", ProviderModuleID: "b2"},
+		{ConsumerModuleID: "b1", InterfaceID: "IA1", ProviderModuleID: "a1"},
+		{ConsumerModuleID: "a1", InterfaceID: "IA2", ProviderModuleID: "a2"},
+		{ConsumerModuleID: "x", InterfaceID: "IA1", ProviderModuleID: "a1"},
+	}
+	teams := map[string]string{"a1": "team-a", "a2": "team-a", "b1": "team-b", "b2": "team-b"}
+
+	assert.Equal(t, []TeamDependency{
+		{ConsumerTeam: "team-a", ProviderTeam: "team-b", EdgeCount: 3, Interfaces: []string{"IB1", "IB2"}},
+		{ConsumerTeam: "team-b", ProviderTeam: "team-a", EdgeCount: 1, Interfaces: []string{"IA1"}},
+	}, teamDependencies(edges, teams))
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	complexity "github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -54,6 +55,85 @@ func (mr *MockCatalogerMockRecorder) Close(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockCataloger)(nil).Close), ctx)
 }
 
+// FilterModules mocks base method.
+func (m *MockCataloger) FilterModules(ctx context.Context, filter ModuleFilter) ([]Module, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterModules", ctx, filter)
+	ret0, _ := ret[0].([]Module)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterModules indicates an expected call of FilterModules.
+func (mr *MockCatalogerMockRecorder) FilterModules(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterModules", reflect.TypeOf((*MockCataloger)(nil).FilterModules), ctx, filter)
+}
+
+// GetComplexityBreakdown mocks base method.
+func (m *MockCataloger) GetComplexityBreakdown(ctx context.Context, id, profile string) (ComplexityBreakdown, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplexityBreakdown", ctx, id, profile)
+	ret0, _ := ret[0].(ComplexityBreakdown)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetComplexityBreakdown indicates an expected call of GetComplexityBreakdown.
+func (mr *MockCatalogerMockRecorder) GetComplexityBreakdown(ctx, id, profile any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplexityBreakdown", reflect.TypeOf((*MockCataloger)(nil).GetComplexityBreakdown), ctx, id, profile)
+}
+
+// GetDependencyGraph mocks base method.
+func (m *MockCataloger) GetDependencyGraph(ctx context.Context, id string, direction Direction, depth int) (DependencyGraph, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDependencyGraph", ctx, id, direction, depth)
+	ret0, _ := ret[0].(DependencyGraph)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDependencyGraph indicates an expected call of GetDependencyGraph.
+func (mr *MockCatalogerMockRecorder) GetDependencyGraph(ctx, id, direction, depth any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDependencyGraph", reflect.TypeOf((*MockCataloger)(nil).GetDependencyGraph), ctx, id, direction, depth)
+}
+
+// GetFlowOnID mocks base method.
+func (m *MockCataloger) GetFlowOnID(ctx context.Context, id string) (Flow, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlowOnID", ctx, id)
+	ret0, _ := ret[0].(Flow)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFlowOnID indicates an expected call of GetFlowOnID.
+func (mr *MockCatalogerMockRecorder) GetFlowOnID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlowOnID", reflect.TypeOf((*MockCataloger)(nil).GetFlowOnID), ctx, id)
+}
+
+// GetGradleClosure mocks base method.
+func (m *MockCataloger) GetGradleClosure(ctx context.Context, id string, direction Direction) (GradleClosure, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGradleClosure", ctx, id, direction)
+	ret0, _ := ret[0].(GradleClosure)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGradleClosure indicates an expected call of GetGradleClosure.
+func (mr *MockCatalogerMockRecorder) GetGradleClosure(ctx, id, direction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGradleClosure", reflect.TypeOf((*MockCataloger)(nil).GetGradleClosure), ctx, id, direction)
+}
+
 // GetGradleDependenciesOfModule mocks base method.
 func (m *MockCataloger) GetGradleDependenciesOfModule(ctx context.Context, id string) ([]string, bool, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +150,52 @@ func (mr *MockCatalogerMockRecorder) GetGradleDependenciesOfModule(ctx, id any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGradleDependenciesOfModule", reflect.TypeOf((*MockCataloger)(nil).GetGradleDependenciesOfModule), ctx, id)
 }
 
+// GetHygieneReport mocks base method.
+func (m *MockCataloger) GetHygieneReport(ctx context.Context) (HygieneReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHygieneReport", ctx)
+	ret0, _ := ret[0].(HygieneReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHygieneReport indicates an expected call of GetHygieneReport.
+func (mr *MockCatalogerMockRecorder) GetHygieneReport(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHygieneReport", reflect.TypeOf((*MockCataloger)(nil).GetHygieneReport), ctx)
+}
+
+// GetImpactAnalysis mocks base method.
+func (m *MockCataloger) GetImpactAnalysis(ctx context.Context, subject ImpactSubject, id string, depth int) (ImpactAnalysis, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImpactAnalysis", ctx, subject, id, depth)
+	ret0, _ := ret[0].(ImpactAnalysis)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetImpactAnalysis indicates an expected call of GetImpactAnalysis.
+func (mr *MockCatalogerMockRecorder) GetImpactAnalysis(ctx, subject, id, depth any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImpactAnalysis", reflect.TypeOf((*MockCataloger)(nil).GetImpactAnalysis), ctx, subject, id, depth)
+}
+
+// GetInterfaceComplexityProfile mocks base method.
+func (m *MockCataloger) GetInterfaceComplexityProfile(ctx context.Context) (complexity.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInterfaceComplexityProfile", ctx)
+	ret0, _ := ret[0].(complexity.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInterfaceComplexityProfile indicates an expected call of GetInterfaceComplexityProfile.
+func (mr *MockCatalogerMockRecorder) GetInterfaceComplexityProfile(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterfaceComplexityProfile", reflect.TypeOf((*MockCataloger)(nil).GetInterfaceComplexityProfile), ctx)
+}
+
 // GetInterfaceOnID mocks base method.
 func (m *MockCataloger) GetInterfaceOnID(ctx context.Context, id string) (Interface, bool, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +212,38 @@ func (mr *MockCatalogerMockRecorder) GetInterfaceOnID(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterfaceOnID", reflect.TypeOf((*MockCataloger)(nil).GetInterfaceOnID), ctx, id)
 }
 
+// GetJobOnID mocks base method.
+func (m *MockCataloger) GetJobOnID(ctx context.Context, id string) (Job, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobOnID", ctx, id)
+	ret0, _ := ret[0].(Job)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetJobOnID indicates an expected call of GetJobOnID.
+func (mr *MockCatalogerMockRecorder) GetJobOnID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobOnID", reflect.TypeOf((*MockCataloger)(nil).GetJobOnID), ctx, id)
+}
+
+// GetMethodOnID mocks base method.
+func (m *MockCataloger) GetMethodOnID(ctx context.Context, id string) (Method, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMethodOnID", ctx, id)
+	ret0, _ := ret[0].(Method)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMethodOnID indicates an expected call of GetMethodOnID.
+func (mr *MockCatalogerMockRecorder) GetMethodOnID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMethodOnID", reflect.TypeOf((*MockCataloger)(nil).GetMethodOnID), ctx, id)
+}
+
 // GetModuleOnID mocks base method.
 func (m *MockCataloger) GetModuleOnID(ctx context.Context, id string) (Module, bool, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +260,52 @@ func (mr *MockCatalogerMockRecorder) GetModuleOnID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleOnID", reflect.TypeOf((*MockCataloger)(nil).GetModuleOnID), ctx, id)
 }
 
+// GetSchema mocks base method.
+func (m *MockCataloger) GetSchema(ctx context.Context) ([]TableSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchema", ctx)
+	ret0, _ := ret[0].([]TableSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchema indicates an expected call of GetSchema.
+func (mr *MockCatalogerMockRecorder) GetSchema(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchema", reflect.TypeOf((*MockCataloger)(nil).GetSchema), ctx)
+}
+
+// GetTeamOnID mocks base method.
+func (m *MockCataloger) GetTeamOnID(ctx context.Context, id string) (Team, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamOnID", ctx, id)
+	ret0, _ := ret[0].(Team)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTeamOnID indicates an expected call of GetTeamOnID.
+func (mr *MockCatalogerMockRecorder) GetTeamOnID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamOnID", reflect.TypeOf((*MockCataloger)(nil).GetTeamOnID), ctx, id)
+}
+
+// ListComplexityProfiles mocks base method.
+func (m *MockCataloger) ListComplexityProfiles(ctx context.Context) ([]complexity.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComplexityProfiles", ctx)
+	ret0, _ := ret[0].([]complexity.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComplexityProfiles indicates an expected call of ListComplexityProfiles.
+func (mr *MockCatalogerMockRecorder) ListComplexityProfiles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplexityProfiles", reflect.TypeOf((*MockCataloger)(nil).ListComplexityProfiles), ctx)
+}
+
 // ListConsumersOfGradleModule mocks base method.
 func (m *MockCataloger) ListConsumersOfGradleModule(ctx context.Context, id string) ([]string, bool, error) {
 	m.ctrl.T.Helper()
@@ -149,6 +353,36 @@ func (mr *MockCatalogerMockRecorder) ListDatabases(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDatabases", reflect.TypeOf((*MockCataloger)(nil).ListDatabases), ctx)
 }
 
+// ListDependencyCycles mocks base method.
+func (m *MockCataloger) ListDependencyCycles(ctx context.Context) ([]DependencyCycle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDependencyCycles", ctx)
+	ret0, _ := ret[0].([]DependencyCycle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDependencyCycles indicates an expected call of ListDependencyCycles.
+func (mr *MockCatalogerMockRecorder) ListDependencyCycles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDependencyCycles", reflect.TypeOf((*MockCataloger)(nil).ListDependencyCycles), ctx)
+}
+
+// ListDependencyEdgesBetween mocks base method.
+func (m *MockCataloger) ListDependencyEdgesBetween(ctx context.Context, moduleIDs []string) ([]DependencyEdge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDependencyEdgesBetween", ctx, moduleIDs)
+	ret0, _ := ret[0].([]DependencyEdge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDependencyEdgesBetween indicates an expected call of ListDependencyEdgesBetween.
+func (mr *MockCatalogerMockRecorder) ListDependencyEdgesBetween(ctx, moduleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDependencyEdgesBetween", reflect.TypeOf((*MockCataloger)(nil).ListDependencyEdgesBetween), ctx, moduleIDs)
+}
+
 // ListFlows mocks base method.
 func (m *MockCataloger) ListFlows(ctx context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -180,6 +414,21 @@ func (mr *MockCatalogerMockRecorder) ListInterfaceConsumers(ctx, id any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInterfaceConsumers", reflect.TypeOf((*MockCataloger)(nil).ListInterfaceConsumers), ctx, id)
 }
 
+// ListInterfaceMetrics mocks base method.
+func (m *MockCataloger) ListInterfaceMetrics(ctx context.Context) ([]Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInterfaceMetrics", ctx)
+	ret0, _ := ret[0].([]Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInterfaceMetrics indicates an expected call of ListInterfaceMetrics.
+func (mr *MockCatalogerMockRecorder) ListInterfaceMetrics(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInterfaceMetrics", reflect.TypeOf((*MockCataloger)(nil).ListInterfaceMetrics), ctx)
+}
+
 // ListInterfaces mocks base method.
 func (m *MockCataloger) ListInterfaces(ctx context.Context, keyword string) ([]Interface, error) {
 	m.ctrl.T.Helper()
@@ -195,19 +444,19 @@ func (mr *MockCatalogerMockRecorder) ListInterfaces(ctx, keyword any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInterfaces", reflect.TypeOf((*MockCataloger)(nil).ListInterfaces), ctx, keyword)
 }
 
-// ListInterfacesByComplexity mocks base method.
-func (m *MockCataloger) ListInterfacesByComplexity(ctx context.Context, limit int) ([]Interface, error) {
+// ListJobs mocks base method.
+func (m *MockCataloger) ListJobs(ctx context.Context, keyword string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListInterfacesByComplexity", ctx, limit)
-	ret0, _ := ret[0].([]Interface)
+	ret := m.ctrl.Call(m, "ListJobs", ctx, keyword)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListInterfacesByComplexity indicates an expected call of ListInterfacesByComplexity.
-func (mr *MockCatalogerMockRecorder) ListInterfacesByComplexity(ctx, limit any) *gomock.Call {
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockCatalogerMockRecorder) ListJobs(ctx, keyword any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInterfacesByComplexity", reflect.TypeOf((*MockCataloger)(nil).ListInterfacesByComplexity), ctx, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockCataloger)(nil).ListJobs), ctx, keyword)
 }
 
 // ListKinds mocks base method.
@@ -256,18 +505,19 @@ func (mr *MockCatalogerMockRecorder) ListModules(ctx, keyword any) *gomock.Call
 }
 
 // ListModulesByCompexity mocks base method.
-func (m *MockCataloger) ListModulesByCompexity(ctx context.Context, limit int) ([]Module, error) {
+func (m *MockCataloger) ListModulesByCompexity(ctx context.Context, limit int, profile string) ([]Module, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListModulesByCompexity", ctx, limit)
+	ret := m.ctrl.Call(m, "ListModulesByCompexity", ctx, limit, profile)
 	ret0, _ := ret[0].([]Module)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // ListModulesByCompexity indicates an expected call of ListModulesByCompexity.
-func (mr *MockCatalogerMockRecorder) ListModulesByCompexity(ctx, limit any) *gomock.Call {
+func (mr *MockCatalogerMockRecorder) ListModulesByCompexity(ctx, limit, profile any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModulesByCompexity", reflect.TypeOf((*MockCataloger)(nil).ListModulesByCompexity), ctx, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModulesByCompexity", reflect.TypeOf((*MockCataloger)(nil).ListModulesByCompexity), ctx, limit, profile)
 }
 
 // ListModulesOfTeam mocks base method.
@@ -318,6 +568,52 @@ func (mr *MockCatalogerMockRecorder) ListParticpantsOfFlow(ctx, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListParticpantsOfFlow", reflect.TypeOf((*MockCataloger)(nil).ListParticpantsOfFlow), ctx, id)
 }
 
+// ListRelationMembers mocks base method.
+func (m *MockCataloger) ListRelationMembers(ctx context.Context, relation Relation) ([]RelationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRelationMembers", ctx, relation)
+	ret0, _ := ret[0].([]RelationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRelationMembers indicates an expected call of ListRelationMembers.
+func (mr *MockCatalogerMockRecorder) ListRelationMembers(ctx, relation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRelationMembers", reflect.TypeOf((*MockCataloger)(nil).ListRelationMembers), ctx, relation)
+}
+
+// ListShortestPaths mocks base method.
+func (m *MockCataloger) ListShortestPaths(ctx context.Context, fromID, toID string, limit int) ([]DependencyPath, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShortestPaths", ctx, fromID, toID, limit)
+	ret0, _ := ret[0].([]DependencyPath)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShortestPaths indicates an expected call of ListShortestPaths.
+func (mr *MockCatalogerMockRecorder) ListShortestPaths(ctx, fromID, toID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShortestPaths", reflect.TypeOf((*MockCataloger)(nil).ListShortestPaths), ctx, fromID, toID, limit)
+}
+
+// ListTeamDependencies mocks base method.
+func (m *MockCataloger) ListTeamDependencies(ctx context.Context, id string) (TeamDependencies, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamDependencies", ctx, id)
+	ret0, _ := ret[0].(TeamDependencies)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTeamDependencies indicates an expected call of ListTeamDependencies.
+func (mr *MockCatalogerMockRecorder) ListTeamDependencies(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamDependencies", reflect.TypeOf((*MockCataloger)(nil).ListTeamDependencies), ctx, id)
+}
+
 // ListTeams mocks base method.
 func (m *MockCataloger) ListTeams(ctx context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -346,3 +642,18 @@ func (mr *MockCatalogerMockRecorder) Open(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockCataloger)(nil).Open), ctx)
 }
+
+// QueryCatalog mocks base method.
+func (m *MockCataloger) QueryCatalog(ctx context.Context, query string, maxRows int) (QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryCatalog", ctx, query, maxRows)
+	ret0, _ := ret[0].(QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryCatalog indicates an expected call of QueryCatalog.
+func (mr *MockCatalogerMockRecorder) QueryCatalog(ctx, query, maxRows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryCatalog", reflect.TypeOf((*MockCataloger)(nil).QueryCatalog), ctx, query, maxRows)
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: semantic_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=semantic_store.go -destination=mock_semantic_store.go -package=repo SemanticModelStorer
+//
+
+// Package repo is a generated GoMock package.
+package repo
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSemanticModelStorer is a mock of SemanticModelStorer interface.
+type MockSemanticModelStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSemanticModelStorerMockRecorder
+	isgomock struct{}
+}
+
+// MockSemanticModelStorerMockRecorder is the mock recorder for MockSemanticModelStorer.
+type MockSemanticModelStorerMockRecorder struct {
+	mock *MockSemanticModelStorer
+}
+
+// NewMockSemanticModelStorer creates a new mock instance.
+func NewMockSemanticModelStorer(ctrl *gomock.Controller) *MockSemanticModelStorer {
+	mock := &MockSemanticModelStorer{ctrl: ctrl}
+	mock.recorder = &MockSemanticModelStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSemanticModelStorer) EXPECT() *MockSemanticModelStorerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSemanticModelStorer) Close(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSemanticModelStorerMockRecorder) Close(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSemanticModelStorer)(nil).Close), ctx)
+}
+
+// GetSemanticModel mocks base method.
+func (m *MockSemanticModelStorer) GetSemanticModel(ctx context.Context, fingerprint string) (SemanticModel, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSemanticModel", ctx, fingerprint)
+	ret0, _ := ret[0].(SemanticModel)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSemanticModel indicates an expected call of GetSemanticModel.
+func (mr *MockSemanticModelStorerMockRecorder) GetSemanticModel(ctx, fingerprint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSemanticModel", reflect.TypeOf((*MockSemanticModelStorer)(nil).GetSemanticModel), ctx, fingerprint)
+}
+
+// Open mocks base method.
+func (m *MockSemanticModelStorer) Open(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockSemanticModelStorerMockRecorder) Open(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockSemanticModelStorer)(nil).Open), ctx)
+}
+
+// PutSemanticModel mocks base method.
+func (m *MockSemanticModelStorer) PutSemanticModel(ctx context.Context, model SemanticModel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSemanticModel", ctx, model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutSemanticModel indicates an expected call of PutSemanticModel.
+func (mr *MockSemanticModelStorerMockRecorder) PutSemanticModel(ctx, model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSemanticModel", reflect.TypeOf((*MockSemanticModelStorer)(nil).PutSemanticModel), ctx, model)
+}
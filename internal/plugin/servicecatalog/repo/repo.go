@@ -1,31 +1,59 @@
 package repo
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
+	"path/filepath"
+	"slices"
 	"sort"
+	"strings"
+	"sync"
 
 	_ "github.com/glebarez/go-sqlite" // sqlite driver
 	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
 )
 
+// Option configures optional behaviour of the catalog repository.
+type Option func(r *CatalogRepo)
+
+// WithComplexityConfig configures the profiles used to score the complexity of modules.
+func WithComplexityConfig(config complexity.Config) Option {
+	return func(r *CatalogRepo) {
+		r.complexity = config
+	}
+}
+
 // New creates a new Cataloger instance.
-func New(filename string) Cataloger {
-	return newCatalogRepo(filename)
+func New(filename string, options ...Option) Cataloger {
+	r := newCatalogRepo(filename)
+	for _, option := range options {
+		option(r)
+	}
+	return r
 }
 
 // CatalogRepo is an implementation of Cataloger using a SQLite database.
 type CatalogRepo struct {
-	filename string
-	db       *sqlx.DB
+	filename   string
+	db         *sqlx.DB
+	readOnlyDB *sqlx.DB // for queries written by clients
+	complexity complexity.Config
+	mutex      sync.Mutex
+	maxima     complexity.Values // cached: the catalog does not change while it is open
 }
 
 func newCatalogRepo(filename string) *CatalogRepo {
 	return &CatalogRepo{
-		filename: filename,
+		filename:   filename,
+		complexity: complexity.DefaultConfig(),
 	}
 }
 
@@ -51,6 +79,16 @@ func (r *CatalogRepo) Open(ctx context.Context) error {
 		return fmt.Errorf("connect error: %w", err)
 	}
 
+	absoluteFilename, err := filepath.Abs(r.filename)
+	if err != nil {
+		return fmt.Errorf("Error resolving file %s: %s", r.filename, err)
+	}
+	readOnlyURI := url.URL{Scheme: "file", Path: absoluteFilename, RawQuery: "mode=ro"}
+	r.readOnlyDB, err = sqlx.Connect("sqlite", readOnlyURI.String())
+	if err != nil {
+		return fmt.Errorf("connect read-only error: %w", err)
+	}
+
 	return nil
 }
 
@@ -61,6 +99,9 @@ func (r *CatalogRepo) Close(ctx context.Context) error {
 		// already closed
 		return nil
 	}
+	if r.readOnlyDB != nil {
+		r.readOnlyDB.Close()
+	}
 	return r.db.Close()
 }
 
@@ -82,7 +123,7 @@ func (r *CatalogRepo) ListModules(ctx context.Context, keyword string) ([]Module
 			return nil, fmt.Errorf("select error: %w", err)
 		}
 
-		return enrichWithComplexityScore(modules), nil
+		return modules, nil
 	}
 
 	modules := []Module{}
@@ -94,42 +135,209 @@ func (r *CatalogRepo) ListModules(ctx context.Context, keyword string) ([]Module
 		}
 	}
 
-	return enrichWithComplexityScore(modules), nil
+	return modules, nil
 }
 
-// ListModulesByCompexity lists modules ordered by complexity.
-func (r *CatalogRepo) ListModulesByCompexity(ctx context.Context, limit int) ([]Module, error) {
+// FilterModules lists the modules that match all criteria of the filter, ordered on the sort field of the filter.
+// Ties are ordered on module ID.
+func (r *CatalogRepo) FilterModules(ctx context.Context, filter ModuleFilter) ([]Module, error) {
 	if r.db == nil {
 		return nil, fmt.Errorf("database not yet opened")
 	}
 
+	conditions := []string{}
+	args := []any{}
+	where := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, fmt.Sprintf("$%d", len(args))))
+	}
+	if filter.Keyword != "" {
+		where("module_id LIKE %s", wildcard(filter.Keyword))
+	}
+	if filter.Team != "" {
+		where("team = %s", filter.Team)
+	}
+	if filter.Kind != "" {
+		where("module_id IN (SELECT module_id FROM mod_kind WHERE kind_id = %s)", filter.Kind)
+	}
+	if filter.Flow != "" {
+		where("module_id IN (SELECT module_id FROM mod_flow WHERE flow_id = %s)", filter.Flow)
+	}
+	if filter.Database != "" {
+		where("module_id IN (SELECT module_id FROM mod_database WHERE database_id = %s)", filter.Database)
+	}
+	if filter.MinLineCount != nil {
+		where("line_count >= %s", *filter.MinLineCount)
+	}
+	if filter.MaxLineCount != nil {
+		where("line_count <= %s", *filter.MaxLineCount)
+	}
+	query := "SELECT module_id FROM module"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	moduleIDs := []string{}
+	err := r.db.Select(&moduleIDs, query, args...)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("select filtered modules error: %w", err)
+	}
+	selected := lo.SliceToMap(moduleIDs, func(id string) (string, bool) { return id, true })
+
+	// complexity needs the metrics of all modules
+	modules, err := r.listModuleMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	scoring, _ := r.complexity.Profile("")
+	scoreModules(modules, scoring, complexity.Maxima(complexityValues(modules)))
+
+	modules = lo.Filter(modules, func(m Module, _ int) bool {
+		score := float64(m.ComplexityScore)
+		return selected[m.ModuleID] &&
+			(filter.MinComplexity == nil || score >= *filter.MinComplexity) &&
+			(filter.MaxComplexity == nil || score <= *filter.MaxComplexity)
+	})
+
+	comparators := map[ModuleSortField]func(a, b Module) int{
+		SortOnModuleID:   func(a, b Module) int { return strings.Compare(a.ModuleID, b.ModuleID) },
+		SortOnLineCount:  func(a, b Module) int { return cmp.Compare(a.LineCount, b.LineCount) },
+		SortOnFileCount:  func(a, b Module) int { return cmp.Compare(a.FileCount, b.FileCount) },
+		SortOnComplexity: func(a, b Module) int { return cmp.Compare(a.ComplexityScore, b.ComplexityScore) },
+	}
+	compare, found := comparators[filter.SortBy]
+	if !found {
+		return nil, fmt.Errorf("unknown sort field %s", filter.SortBy)
+	}
+	slices.SortFunc(modules, func(a, b Module) int {
+		order := compare(a, b)
+		if filter.Descending {
+			order = -order
+		}
+		if order == 0 {
+			return strings.Compare(a.ModuleID, b.ModuleID)
+		}
+		return order
+	})
+
+	return modules, nil
+}
+
+// ListModulesByCompexity lists modules ordered by their complexity score according to a scoring profile. An empty profile uses the default profile.
+func (r *CatalogRepo) ListModulesByCompexity(ctx context.Context, limit int, profile string) ([]Module, bool, error) {
+	if r.db == nil {
+		return nil, false, fmt.Errorf("database not yet opened")
+	}
+
+	scoring, found := r.complexity.Profile(profile)
+	if !found {
+		return []Module{}, false, nil
+	}
+
+	modules, err := r.listModuleMetrics(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	scoreModules(modules, scoring, complexity.Maxima(complexityValues(modules)))
+
+	sort.SliceStable(modules, func(i, j int) bool {
+		return modules[i].ComplexityScore > modules[j].ComplexityScore
+	})
+
+	return modules[0:min(limit, len(modules))], true, nil
+}
+
+// ListComplexityProfiles lists the profiles that can be used to score the complexity of modules
+func (r *CatalogRepo) ListComplexityProfiles(ctx context.Context) ([]complexity.Profile, error) {
+	return r.complexity.Profiles, nil
+}
+
+// GetComplexityBreakdown explains the complexity score of a module according to a scoring profile. An empty profile uses the default profile.
+func (r *CatalogRepo) GetComplexityBreakdown(ctx context.Context, id string, profile string) (ComplexityBreakdown, bool, error) {
+	if r.db == nil {
+		return ComplexityBreakdown{}, false, fmt.Errorf("database not yet opened")
+	}
+
+	scoring, found := r.complexity.Profile(profile)
+	if !found {
+		return ComplexityBreakdown{}, false, fmt.Errorf("unknown complexity profile %s", profile)
+	}
+
+	modules, err := r.listModuleMetrics(ctx)
+	if err != nil {
+		return ComplexityBreakdown{}, false, err
+	}
+
+	module, found := lo.Find(modules, func(m Module) bool { return m.ModuleID == id })
+	if !found {
+		return ComplexityBreakdown{}, false, nil
+	}
+	team := lo.Filter(modules, func(m Module, _ int) bool { return m.Team == module.Team })
+
+	return ComplexityBreakdown{
+		ModuleID:        id,
+		Team:            module.Team,
+		TeamModuleCount: len(team),
+		Breakdown:       complexity.Explain(scoring, module.ComplexityValues(), complexityValues(modules), complexityValues(team)),
+	}, true, nil
+}
+
+// listModuleMetrics returns all modules with the counts and fan-in/fan-out needed for complexity scoring
+func (r *CatalogRepo) listModuleMetrics(ctx context.Context) ([]Module, error) {
 	modules := []Module{}
 	// This must use module and fails with enriched_module. Don't know why.
 	// Currently returns about 2500 entries. Acceptable for now.
 	err := r.db.Select(&modules, "SELECT * FROM enriched_module")
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return modules, nil
-		}
+	if err != nil && err != sql.ErrNoRows {
 		return nil, fmt.Errorf("select error: %w", err)
 	}
 
+	edges, err := r.listDependencyEdges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	graph := newModuleGraph(edges)
 	for i, module := range modules {
-		modules[i].ComplexityScore = module.CalculateComplexityScore()
+		modules[i].FanIn = intPointer(graph.fanIn(module.ModuleID))
+		modules[i].FanOut = intPointer(graph.fanOut(module.ModuleID))
 	}
 
-	sort.Slice(modules, func(i, j int) bool {
-		return modules[i].ComplexityScore > modules[j].ComplexityScore
-	})
+	return modules, nil
+}
 
-	return modules[0:min(limit, len(modules))], nil
+// complexityMaxima returns the largest value of each metric across all modules, when the profile needs them.
+// They are computed on first use only, because that needs the metrics of all modules.
+func (r *CatalogRepo) complexityMaxima(ctx context.Context, profile complexity.Profile) (complexity.Values, error) {
+	if !profile.NeedsMaxima() {
+		return complexity.Values{}, nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.maxima == nil {
+		modules, err := r.listModuleMetrics(ctx)
+		if err != nil {
+			return nil, err
+		}
+		r.maxima = complexity.Maxima(complexityValues(modules))
+	}
+	return r.maxima, nil
 }
 
-func enrichWithComplexityScore(modules []Module) []Module {
+func scoreModules(modules []Module, profile complexity.Profile, maxima complexity.Values) {
+	for i, module := range modules {
+		modules[i].ComplexityScore = float32(profile.Score(module.ComplexityValues(), maxima))
+	}
+}
+
+func complexityValues(modules []Module) []complexity.Values {
+	values := []complexity.Values{}
 	for _, module := range modules {
-		module.ComplexityScore = module.CalculateComplexityScore()
+		values = append(values, module.ComplexityValues())
 	}
-	return modules
+	return values
 }
 
 // ListModulesOfTeam lists modules belonging to a specific team.
@@ -158,6 +366,65 @@ func (r *CatalogRepo) ListModulesOfTeam(ctx context.Context, id string) ([]strin
 	return modules, true, nil
 }
 
+// GetTeamOnID retrieves a team with the modules it owns and their aggregated metrics.
+func (r *CatalogRepo) GetTeamOnID(ctx context.Context, id string) (Team, bool, error) {
+	if r.db == nil {
+		return Team{}, false, fmt.Errorf("database not yet opened")
+	}
+
+	modules, err := r.listModuleMetrics(ctx)
+	if err != nil {
+		return Team{}, false, err
+	}
+	scoring, _ := r.complexity.Profile("")
+	scoreModules(modules, scoring, complexity.Maxima(complexityValues(modules)))
+
+	owned := lo.Filter(modules, func(m Module, _ int) bool { return m.Team == id })
+	if len(owned) == 0 {
+		return Team{}, false, nil
+	}
+	sort.Slice(owned, func(i, j int) bool {
+		return owned[i].ModuleID < owned[j].ModuleID
+	})
+
+	team := Team{
+		TeamID:             id,
+		Modules:            []string{},
+		ExposedInterfaces:  []string{},
+		ConsumedInterfaces: []string{},
+		Databases:          []string{},
+		Flows:              []string{},
+		Jobs:               []string{},
+	}
+	for _, module := range owned {
+		team.Modules = append(team.Modules, module.ModuleID)
+		team.LineCount += module.LineCount
+		team.FileCount += module.FileCount
+		team.TotalComplexityScore += module.ComplexityScore
+	}
+	team.ModuleCount = len(owned)
+	team.AverageComplexityScore = team.TotalComplexityScore / float32(team.ModuleCount)
+
+	for _, relation := range []struct {
+		name   string
+		target *[]string
+		query  string
+	}{
+		{name: "exposed-interfaces", target: &team.ExposedInterfaces, query: "SELECT DISTINCT interface_id FROM mod_exposed_interface WHERE module_id IN (SELECT module_id FROM module WHERE team = $1) ORDER BY interface_id"},
+		{name: "consumed-interfaces", target: &team.ConsumedInterfaces, query: "SELECT DISTINCT interface_id FROM mod_consumed_interface WHERE module_id IN (SELECT module_id FROM module WHERE team = $1) ORDER BY interface_id"},
+		{name: "database", target: &team.Databases, query: "SELECT DISTINCT database_id FROM mod_database WHERE module_id IN (SELECT module_id FROM module WHERE team = $1) ORDER BY database_id"},
+		{name: "flow", target: &team.Flows, query: "SELECT DISTINCT flow_id FROM mod_flow WHERE module_id IN (SELECT module_id FROM module WHERE team = $1) ORDER BY flow_id"},
+		{name: "jobs", target: &team.Jobs, query: "SELECT DISTINCT job_id FROM mod_job WHERE module_id IN (SELECT module_id FROM module WHERE team = $1) ORDER BY job_id"},
+	} {
+		err = r.db.Select(relation.target, relation.query, id)
+		if err != nil && err != sql.ErrNoRows {
+			return Team{}, false, fmt.Errorf("select %s error: %w", relation.name, err)
+		}
+	}
+
+	return team, true, nil
+}
+
 // GetModuleOnID retrieves a module by its ID.
 func (r *CatalogRepo) GetModuleOnID(ctx context.Context, id string) (Module, bool, error) {
 	if r.db == nil {
@@ -232,7 +499,34 @@ func (r *CatalogRepo) GetModuleOnID(ctx context.Context, id string) (Module, boo
 		module.Dependencies = dependencies
 		module.DependencyCount = intPointer(len(module.Dependencies))
 	}
-	module.ComplexityScore = module.CalculateComplexityScore()
+
+	// How coupled? Modules consuming their own interfaces do not count, like in the dependency graph
+	fanIn := 0
+	err = r.db.Get(&fanIn, `SELECT COUNT(DISTINCT c.module_id)
+		FROM mod_exposed_interface e
+		INNER JOIN mod_consumed_interface c ON c.interface_id = e.interface_id
+		WHERE e.module_id = $1 AND c.module_id <> $1`, id)
+	if err != nil {
+		return Module{}, false, fmt.Errorf("select fan-in error: %w", err)
+	}
+	module.FanIn = intPointer(fanIn)
+
+	fanOut := 0
+	err = r.db.Get(&fanOut, `SELECT COUNT(DISTINCT e.module_id)
+		FROM mod_consumed_interface c
+		INNER JOIN mod_exposed_interface e ON c.interface_id = e.interface_id
+		WHERE c.module_id = $1 AND e.module_id <> $1`, id)
+	if err != nil {
+		return Module{}, false, fmt.Errorf("select fan-out error: %w", err)
+	}
+	module.FanOut = intPointer(fanOut)
+
+	scoring, _ := r.complexity.Profile("")
+	maxima, err := r.complexityMaxima(ctx, scoring)
+	if err != nil {
+		return Module{}, false, err
+	}
+	module.ComplexityScore = float32(scoring.Score(module.ComplexityValues(), maxima))
 
 	return module, true, nil
 }
@@ -251,7 +545,7 @@ func (r *CatalogRepo) GetInterfaceOnID(ctx context.Context, id string) (Interfac
 	api := Interface{}
 	err := r.db.Get(&api, `
 		SELECT
-			m.module_id, i.interface_id, i.description, i.kind, i.openapi_specification, i.rpl_specification, i.method_count
+			COALESCE(m.module_id, '') AS module_id, i.interface_id, i.description, i.kind, i.openapi_specification, i.rpl_specification, i.method_count
 		FROM
 			enriched_interface i
 			LEFT JOIN mod_exposed_interface m ON i.interface_id = m.interface_id
@@ -284,7 +578,7 @@ func (r *CatalogRepo) ListInterfaces(ctx context.Context, keyword string) ([]Int
 		interfaces := []Interface{}
 		err := r.db.Select(&interfaces, `
 	SELECT 
-		m.module_id, i.interface_id, i.description, i.kind, i.openapi_specification, i.rpl_specification, i.method_count
+		COALESCE(m.module_id, '') AS module_id, i.interface_id, i.description, i.kind, i.openapi_specification, i.rpl_specification, i.method_count
 	FROM 
 		enriched_interface i
 		LEFT JOIN mod_exposed_interface m ON i.interface_id = m.interface_id 
@@ -302,7 +596,7 @@ func (r *CatalogRepo) ListInterfaces(ctx context.Context, keyword string) ([]Int
 	interfaces := []Interface{}
 	err := r.db.Select(&interfaces, `
 	SELECT 
-		m.module_id, i.interface_id, i.description, i.kind, i.openapi_specification, i.rpl_specification, i.method_count
+		COALESCE(m.module_id, '') AS module_id, i.interface_id, i.description, i.kind, i.openapi_specification, i.rpl_specification, i.method_count
 	FROM 
 		enriched_interface i
 		LEFT JOIN mod_exposed_interface m ON i.interface_id = m.interface_id 
@@ -320,30 +614,39 @@ func (r *CatalogRepo) ListInterfaces(ctx context.Context, keyword string) ([]Int
 
 }
 
-// ListInterfacesByComplexity lists interfaces ordered by complexity.
-func (r *CatalogRepo) ListInterfacesByComplexity(ctx context.Context, limit int) ([]Interface, error) {
+// ListInterfaceMetrics lists all interfaces with the team of the exposing module and the number of consuming modules and teams.
+func (r *CatalogRepo) ListInterfaceMetrics(ctx context.Context) ([]Interface, error) {
 	if r.db == nil {
-		// already opened
 		return nil, fmt.Errorf("database not yet opened")
 	}
 
 	interfaces := []Interface{}
 	err := r.db.Select(&interfaces, `
 	SELECT 
-		m.module_id, i.interface_id, i.description, i.kind, i.openapi_specification, i.rpl_specification, i.method_count
+		i.interface_id, i.description, i.kind, i.openapi_specification, i.rpl_specification, i.method_count,
+		COALESCE((SELECT MIN(m.team) FROM mod_exposed_interface e INNER JOIN module m ON e.module_id = m.module_id
+			WHERE e.interface_id = i.interface_id), '') AS team,
+		(SELECT COUNT(DISTINCT c.module_id) FROM mod_consumed_interface c
+			WHERE c.interface_id = i.interface_id) AS consumer_count,
+		(SELECT COUNT(DISTINCT m.team) FROM mod_consumed_interface c INNER JOIN module m ON c.module_id = m.module_id
+			WHERE c.interface_id = i.interface_id) AS consuming_team_count
 	FROM 
 		enriched_interface i
-		LEFT JOIN mod_exposed_interface m ON i.interface_id = m.interface_id 
 	ORDER BY 
-		i.method_count DESC LIMIT $1`, limit)
+		i.interface_id`)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return interfaces, nil
 		}
-		return interfaces, fmt.Errorf("list interface error: %w", err)
+		return nil, fmt.Errorf("list interface metrics error: %w", err)
 	}
 
-	return interfaces[0:min(limit, len(interfaces))], nil
+	return interfaces, nil
+}
+
+// GetInterfaceComplexityProfile returns the profile used to score the complexity of interfaces
+func (r *CatalogRepo) GetInterfaceComplexityProfile(ctx context.Context) (complexity.Profile, error) {
+	return r.complexity.InterfaceProfile, nil
 }
 
 // ListInterfaceConsumers lists modules that consume a given interface.
@@ -390,6 +693,45 @@ func (r *CatalogRepo) ListMethods(ctx context.Context) ([]string, error) {
 	return methods, nil
 }
 
+// GetMethodOnID retrieves a web-method with the interfaces containing it and the modules exposing and consuming these.
+func (r *CatalogRepo) GetMethodOnID(ctx context.Context, id string) (Method, bool, error) {
+	if r.db == nil {
+		return Method{}, false, fmt.Errorf("database not yet opened")
+	}
+
+	method := Method{
+		MethodID:   id,
+		Interfaces: []MethodInterface{},
+	}
+
+	// Which interfaces contain this method?
+	err := r.db.Select(&method.Interfaces, "SELECT DISTINCT interface_id FROM interface_method WHERE method_id = $1 ORDER BY interface_id", id)
+	if err != nil {
+		return Method{}, false, fmt.Errorf("select interfaces of method error: %w", err)
+	}
+	if len(method.Interfaces) == 0 {
+		return Method{}, false, nil
+	}
+
+	for i, iface := range method.Interfaces {
+		// Who exposes this interface?
+		method.Interfaces[i].ExposedBy = []string{}
+		err = r.db.Select(&method.Interfaces[i].ExposedBy, "SELECT DISTINCT module_id FROM mod_exposed_interface WHERE interface_id = $1 ORDER BY module_id", iface.InterfaceID)
+		if err != nil {
+			return Method{}, false, fmt.Errorf("select exposing modules error: %w", err)
+		}
+
+		// Who consumes this interface?
+		method.Interfaces[i].ConsumedBy = []string{}
+		err = r.db.Select(&method.Interfaces[i].ConsumedBy, "SELECT DISTINCT module_id FROM mod_consumed_interface WHERE interface_id = $1 ORDER BY module_id", iface.InterfaceID)
+		if err != nil {
+			return Method{}, false, fmt.Errorf("select consuming modules error: %w", err)
+		}
+	}
+
+	return method, true, nil
+}
+
 // ListDatabaseConsumers lists modules that consume a given database.
 func (r *CatalogRepo) ListDatabaseConsumers(ctx context.Context, id string) ([]string, bool, error) {
 	if r.db == nil {
@@ -499,6 +841,49 @@ func (r *CatalogRepo) ListParticpantsOfFlow(ctx context.Context, id string) ([]s
 	return interfaces, true, nil
 }
 
+// GetFlowOnID returns the participants of a flow with the interfaces connecting them, the teams involved and the entry points of the flow
+func (r *CatalogRepo) GetFlowOnID(ctx context.Context, id string) (Flow, bool, error) {
+	if r.db == nil {
+		return Flow{}, false, fmt.Errorf("database not yet opened")
+	}
+
+	moduleIDs, exists, err := r.ListParticpantsOfFlow(ctx, id)
+	if err != nil || !exists {
+		return Flow{}, exists, err
+	}
+
+	edges, err := r.listDependencyEdges(ctx)
+	if err != nil {
+		return Flow{}, false, err
+	}
+	edges = newModuleGraph(edges).edgesWithin(moduleIDs)
+
+	teams, err := r.listModuleTeams(ctx)
+	if err != nil {
+		return Flow{}, false, err
+	}
+
+	flow := Flow{
+		FlowID:       id,
+		Participants: []FlowParticipant{},
+		Edges:        edges,
+		Teams:        []string{},
+		EntryPoints:  entryPoints(moduleIDs, edges),
+	}
+	seenTeams := map[string]bool{}
+	for _, moduleID := range moduleIDs {
+		team := teams[moduleID]
+		flow.Participants = append(flow.Participants, FlowParticipant{ModuleID: moduleID, Team: team})
+		if team != "" && !seenTeams[team] {
+			seenTeams[team] = true
+			flow.Teams = append(flow.Teams, team)
+		}
+	}
+	sort.Strings(flow.Teams)
+
+	return flow, true, nil
+}
+
 // ListKinds lists all module kinds.
 func (r *CatalogRepo) ListKinds(ctx context.Context) ([]string, error) {
 	if r.db == nil {
@@ -586,6 +971,667 @@ func (r *CatalogRepo) ListConsumersOfGradleModule(ctx context.Context, id string
 	return consumers, true, nil
 }
 
+// ListJobs lists all scheduled jobs, optionally filtered on a keyword.
+func (r *CatalogRepo) ListJobs(ctx context.Context, keyword string) ([]string, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("database not yet opened")
+	}
+
+	query := "SELECT DISTINCT job_id FROM mod_job ORDER BY job_id ASC"
+	args := []interface{}{}
+	if keyword != "" {
+		query = "SELECT DISTINCT job_id FROM mod_job WHERE job_id LIKE $1 ORDER BY job_id ASC"
+		args = append(args, wildcard(keyword))
+	}
+
+	jobs := []string{}
+	err := r.db.Select(&jobs, query, args...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return jobs, nil
+		}
+		return []string{}, fmt.Errorf("select jobs error: %w", err)
+	}
+	return jobs, nil
+}
+
+// GetJobOnID retrieves a scheduled job with the modules that run it and the teams owning these modules.
+func (r *CatalogRepo) GetJobOnID(ctx context.Context, id string) (Job, bool, error) {
+	if r.db == nil {
+		return Job{}, false, fmt.Errorf("database not yet opened")
+	}
+
+	job := Job{
+		JobID:   id,
+		Modules: []string{},
+		Teams:   []string{},
+	}
+
+	// Which modules run this job?
+	err := r.db.Select(&job.Modules, "SELECT DISTINCT module_id FROM mod_job WHERE job_id = $1 ORDER BY module_id", id)
+	if err != nil {
+		return Job{}, false, fmt.Errorf("select modules of job error: %w", err)
+	}
+	if len(job.Modules) == 0 {
+		return Job{}, false, nil
+	}
+
+	// Who owns these modules?
+	err = r.db.Select(&job.Teams, `SELECT DISTINCT m.team
+		FROM mod_job j
+		INNER JOIN module m ON j.module_id = m.module_id
+		WHERE j.job_id = $1 AND m.team <> ''
+		ORDER BY m.team`, id)
+	if err != nil {
+		return Job{}, false, fmt.Errorf("select teams of job error: %w", err)
+	}
+
+	return job, true, nil
+}
+
+// GetDependencyGraph lists the modules that are transitively connected to a module via interfaces
+func (r *CatalogRepo) GetDependencyGraph(ctx context.Context, id string, direction Direction, depth int) (DependencyGraph, bool, error) {
+	if r.db == nil {
+		return DependencyGraph{}, false, fmt.Errorf("database not yet opened")
+	}
+
+	exists, err := r.moduleExists(ctx, id)
+	if err != nil {
+		return DependencyGraph{}, false, err
+	}
+	if !exists {
+		return DependencyGraph{}, false, nil
+	}
+
+	edges, err := r.listDependencyEdges(ctx)
+	if err != nil {
+		return DependencyGraph{}, false, err
+	}
+
+	nodes, edges := newModuleGraph(edges).traverse(id, direction, depth)
+
+	return DependencyGraph{
+		RootModuleID: id,
+		Direction:    direction,
+		Depth:        depth,
+		Nodes:        nodes,
+		Edges:        edges,
+	}, true, nil
+}
+
+// ListShortestPaths lists the shortest chains of consumed interfaces connecting one module to another.
+// It returns an UnknownModuleError naming the first module that does not exist.
+func (r *CatalogRepo) ListShortestPaths(ctx context.Context, fromID, toID string, limit int) ([]DependencyPath, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("database not yet opened")
+	}
+
+	for _, id := range []string{fromID, toID} {
+		exists, err := r.moduleExists(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, UnknownModuleError{ModuleID: id}
+		}
+	}
+
+	edges, err := r.listDependencyEdges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []DependencyPath{}
+	for _, hops := range newModuleGraph(edges).shortestPaths(fromID, toID, limit) {
+		paths = append(paths, DependencyPath{
+			Length: len(hops),
+			Hops:   hops,
+		})
+	}
+
+	return paths, nil
+}
+
+// ListDependencyCycles lists the groups of modules that depend on each other via consumed interfaces
+func (r *CatalogRepo) ListDependencyCycles(ctx context.Context) ([]DependencyCycle, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("database not yet opened")
+	}
+
+	edges, err := r.listDependencyEdges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := newModuleGraph(edges)
+	cycles := []DependencyCycle{}
+	for _, component := range graph.stronglyConnectedComponents() {
+		cycles = append(cycles, DependencyCycle{
+			Modules: component,
+			Edges:   graph.edgesWithin(component),
+		})
+	}
+
+	return cycles, nil
+}
+
+// ListDependencyEdgesBetween lists the consumed interfaces that connect modules of the given set
+func (r *CatalogRepo) ListDependencyEdgesBetween(ctx context.Context, moduleIDs []string) ([]DependencyEdge, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("database not yet opened")
+	}
+
+	edges, err := r.listDependencyEdges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newModuleGraph(edges).edgesWithin(moduleIDs), nil
+}
+
+// ListTeamDependencies lists which teams consume interfaces of which other teams. An empty id returns all teams.
+func (r *CatalogRepo) ListTeamDependencies(ctx context.Context, id string) (TeamDependencies, bool, error) {
+	if r.db == nil {
+		return TeamDependencies{}, false, fmt.Errorf("database not yet opened")
+	}
+
+	if id != "" {
+		team := ""
+		err := r.db.Get(&team, "SELECT team FROM module WHERE team = $1 LIMIT 1", id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return TeamDependencies{}, false, nil
+			}
+			return TeamDependencies{}, false, fmt.Errorf("select team error: %w", err)
+		}
+	}
+
+	edges, err := r.listDependencyEdges(ctx)
+	if err != nil {
+		return TeamDependencies{}, false, err
+	}
+	teams, err := r.listModuleTeams(ctx)
+	if err != nil {
+		return TeamDependencies{}, false, err
+	}
+
+	dependencies := teamDependencies(edges, teams)
+	if id == "" {
+		return TeamDependencies{Dependencies: dependencies}, true, nil
+	}
+
+	result := TeamDependencies{
+		TeamID:       id,
+		ConsumesFrom: []TeamDependency{},
+		ConsumedBy:   []TeamDependency{},
+	}
+	for _, dependency := range dependencies {
+		if dependency.ConsumerTeam == id {
+			result.ConsumesFrom = append(result.ConsumesFrom, dependency)
+		}
+		if dependency.ProviderTeam == id {
+			result.ConsumedBy = append(result.ConsumedBy, dependency)
+		}
+	}
+
+	return result, true, nil
+}
+
+// GetImpactAnalysis lists the modules affected by a change of an interface or database, grouped by team, flow and kind
+func (r *CatalogRepo) GetImpactAnalysis(ctx context.Context, subject ImpactSubject, id string, depth int) (ImpactAnalysis, bool, error) {
+	if r.db == nil {
+		return ImpactAnalysis{}, false, fmt.Errorf("database not yet opened")
+	}
+
+	var directConsumers []string
+	var exists bool
+	var err error
+	switch subject {
+	case ImpactSubjectInterface:
+		directConsumers, exists, err = r.ListInterfaceConsumers(ctx, id)
+	case ImpactSubjectDatabase:
+		directConsumers, exists, err = r.ListDatabaseConsumers(ctx, id)
+	default:
+		return ImpactAnalysis{}, false, fmt.Errorf("unsupported impact subject %s", subject)
+	}
+	if err != nil || !exists {
+		return ImpactAnalysis{}, exists, err
+	}
+
+	edges, err := r.listDependencyEdges(ctx)
+	if err != nil {
+		return ImpactAnalysis{}, false, err
+	}
+
+	starts := map[string]int{}
+	for _, moduleID := range directConsumers {
+		starts[moduleID] = 1
+	}
+	distances := newModuleGraph(edges).reach(starts, DirectionConsumers, depth)
+
+	teams, err := r.listModuleTeams(ctx)
+	if err != nil {
+		return ImpactAnalysis{}, false, err
+	}
+	flows, err := r.listModuleRelations(ctx, "SELECT module_id, flow_id AS related_id FROM mod_flow ORDER BY flow_id")
+	if err != nil {
+		return ImpactAnalysis{}, false, fmt.Errorf("select flows error: %w", err)
+	}
+	kinds, err := r.listModuleRelations(ctx, "SELECT module_id, kind_id AS related_id FROM mod_kind ORDER BY kind_id")
+	if err != nil {
+		return ImpactAnalysis{}, false, fmt.Errorf("select kinds error: %w", err)
+	}
+
+	analysis := ImpactAnalysis{
+		Subject:         subject,
+		SubjectID:       id,
+		Depth:           depth,
+		AffectedModules: []AffectedModule{},
+		ByTeam:          map[string][]string{},
+		ByFlow:          map[string][]string{},
+		ByKind:          map[string][]string{},
+	}
+	for moduleID, distance := range distances {
+		affected := AffectedModule{
+			ModuleID: moduleID,
+			Distance: distance,
+			Team:     teams[moduleID],
+			Flows:    flows[moduleID],
+			Kinds:    kinds[moduleID],
+		}
+		analysis.AffectedModules = append(analysis.AffectedModules, affected)
+
+		team := affected.Team
+		if team == "" {
+			team = unknownTeam
+		}
+		analysis.ByTeam[team] = append(analysis.ByTeam[team], moduleID)
+		for _, flow := range affected.Flows {
+			analysis.ByFlow[flow] = append(analysis.ByFlow[flow], moduleID)
+		}
+		for _, kind := range affected.Kinds {
+			analysis.ByKind[kind] = append(analysis.ByKind[kind], moduleID)
+		}
+	}
+
+	sort.Slice(analysis.AffectedModules, func(i, j int) bool {
+		if analysis.AffectedModules[i].Distance != analysis.AffectedModules[j].Distance {
+			return analysis.AffectedModules[i].Distance < analysis.AffectedModules[j].Distance
+		}
+		return analysis.AffectedModules[i].ModuleID < analysis.AffectedModules[j].ModuleID
+	})
+	for _, groups := range []map[string][]string{analysis.ByTeam, analysis.ByFlow, analysis.ByKind} {
+		for _, moduleIDs := range groups {
+			sort.Strings(moduleIDs)
+		}
+	}
+
+	return analysis, true, nil
+}
+
+// GetHygieneReport lists interfaces, modules and databases that are not used by any module
+func (r *CatalogRepo) GetHygieneReport(ctx context.Context) (HygieneReport, error) {
+	if r.db == nil {
+		return HygieneReport{}, fmt.Errorf("database not yet opened")
+	}
+
+	report := HygieneReport{
+		UnconsumedInterfaces:      []string{},
+		UnexposedInterfaces:       []string{},
+		ModulesWithoutConsumers:   []string{},
+		DatabasesWithoutConsumers: []string{},
+	}
+
+	// Interfaces that no module consumes
+	err := r.db.Select(&report.UnconsumedInterfaces, `
+	SELECT 
+		i.interface_id
+	FROM 
+		enriched_interface i
+	WHERE 
+		i.interface_id NOT IN (SELECT interface_id FROM mod_consumed_interface)
+	ORDER BY 
+		i.interface_id`)
+	if err != nil && err != sql.ErrNoRows {
+		return HygieneReport{}, fmt.Errorf("select unconsumed interfaces error: %w", err)
+	}
+
+	// Interfaces that no module exposes
+	err = r.db.Select(&report.UnexposedInterfaces, `
+	SELECT 
+		i.interface_id
+	FROM 
+		enriched_interface i
+		LEFT JOIN mod_exposed_interface m ON i.interface_id = m.interface_id 
+	WHERE 
+		m.module_id IS NULL OR m.module_id = ''
+	ORDER BY 
+		i.interface_id`)
+	if err != nil && err != sql.ErrNoRows {
+		return HygieneReport{}, fmt.Errorf("select unexposed interfaces error: %w", err)
+	}
+
+	// Modules whose interfaces are not consumed by any other module
+	err = r.db.Select(&report.ModulesWithoutConsumers, `
+	SELECT 
+		m.module_id
+	FROM 
+		module m
+	WHERE 
+		m.module_id NOT IN (
+			SELECT e.module_id
+			FROM mod_exposed_interface e
+			INNER JOIN mod_consumed_interface c ON c.interface_id = e.interface_id
+			WHERE c.module_id != e.module_id)
+	ORDER BY 
+		m.module_id`)
+	if err != nil && err != sql.ErrNoRows {
+		return HygieneReport{}, fmt.Errorf("select modules without consumers error: %w", err)
+	}
+
+	// Databases that no module uses
+	err = r.db.Select(&report.DatabasesWithoutConsumers, `
+	SELECT DISTINCT
+		d.database_id
+	FROM 
+		database d
+	WHERE 
+		d.database_id NOT IN (SELECT database_id FROM mod_database)
+	ORDER BY 
+		d.database_id`)
+	if err != nil && err != sql.ErrNoRows {
+		return HygieneReport{}, fmt.Errorf("select databases without consumers error: %w", err)
+	}
+
+	return report, nil
+}
+
+const unknownTeam = "unknown"
+
+func (r *CatalogRepo) listModuleTeams(ctx context.Context) (map[string]string, error) {
+	rows := []struct {
+		ModuleID string `db:"module_id"`
+		Team     string `db:"team"`
+	}{}
+	err := r.db.Select(&rows, "SELECT module_id, team FROM module")
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("select teams error: %w", err)
+	}
+
+	teams := map[string]string{}
+	for _, row := range rows {
+		teams[row.ModuleID] = row.Team
+	}
+	return teams, nil
+}
+
+type moduleRelation struct {
+	ModuleID  string `db:"module_id"`
+	RelatedID string `db:"related_id"`
+}
+
+func (r *CatalogRepo) listModuleRelations(ctx context.Context, query string) (map[string][]string, error) {
+	rows := []moduleRelation{}
+	err := r.db.Select(&rows, query)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	relations := map[string][]string{}
+	for _, row := range rows {
+		relations[row.ModuleID] = append(relations[row.ModuleID], row.RelatedID)
+	}
+	return relations, nil
+}
+
+func (r *CatalogRepo) moduleExists(ctx context.Context, id string) (bool, error) {
+	module := ""
+	err := r.db.Get(&module, "SELECT module_id FROM module WHERE module_id = $1", id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("select module error: %w", err)
+	}
+	return true, nil
+}
+
+func (r *CatalogRepo) listDependencyEdges(ctx context.Context) ([]DependencyEdge, error) {
+	edges := []DependencyEdge{}
+	err := r.db.Select(&edges, `SELECT DISTINCT
+			c.module_id AS consumer_module_id, c.interface_id, e.module_id AS provider_module_id
+		FROM mod_consumed_interface c
+		INNER JOIN mod_exposed_interface e ON c.interface_id = e.interface_id
+		ORDER BY c.module_id, e.module_id, c.interface_id`)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return edges, nil
+		}
+		return nil, fmt.Errorf("select dependency edges error: %w", err)
+	}
+	return edges, nil
+}
+
+// GetGradleClosure lists the transitive gradle dependencies or transitive gradle consumers of a module
+func (r *CatalogRepo) GetGradleClosure(ctx context.Context, id string, direction Direction) (GradleClosure, bool, error) {
+	if r.db == nil {
+		return GradleClosure{}, false, fmt.Errorf("database not yet opened")
+	}
+
+	exists, err := r.moduleExists(ctx, id)
+	if err != nil || !exists {
+		return GradleClosure{}, false, err
+	}
+
+	edges := []DependencyEdge{}
+	err = r.db.Select(&edges, `SELECT DISTINCT
+			mg.module_id AS consumer_module_id, gd.module_id AS provider_module_id
+		FROM mod_gradle as mg
+		INNER JOIN gradle_file as gf on mg.gradle_id = gf.gradle_id
+		INNER JOIN gradle_dependency as gd on gd.gradle_id = gf.gradle_id
+		ORDER BY mg.module_id, gd.module_id`)
+	if err != nil && err != sql.ErrNoRows {
+		return GradleClosure{}, false, fmt.Errorf("select gradle dependencies error: %w", err)
+	}
+
+	closure := GradleClosure{
+		ModuleID:  id,
+		Direction: direction,
+		Entries:   newModuleGraph(edges).closure(id, direction),
+		Diamonds:  []GradleClosureEntry{},
+	}
+	for _, entry := range closure.Entries {
+		if len(entry.Via) > 1 {
+			closure.Diamonds = append(closure.Diamonds, entry)
+		}
+	}
+
+	return closure, true, nil
+}
+
+// relationQueries select all members of a relation at once, ordered like the queries for the members of a single entity
+var relationQueries = map[Relation]string{
+	RelationInterfaceMethods:   "SELECT interface_id AS id, method_id AS member_id FROM interface_method ORDER BY interface_id, method_id",
+	RelationInterfaceConsumers: "SELECT interface_id AS id, module_id AS member_id FROM mod_consumed_interface ORDER BY interface_id, module_id",
+	RelationDatabaseConsumers:  "SELECT database_id AS id, module_id AS member_id FROM mod_database ORDER BY database_id, module_id",
+	RelationFlowParticipants:   "SELECT flow_id AS id, module_id AS member_id FROM mod_flow ORDER BY flow_id, module_id",
+}
+
+// ListRelationMembers lists the members of all entities of a relation in a single query,
+// for callers that would otherwise query the members entity by entity.
+func (r *CatalogRepo) ListRelationMembers(ctx context.Context, relation Relation) ([]RelationMember, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("database not yet opened")
+	}
+
+	query, found := relationQueries[relation]
+	if !found {
+		return nil, fmt.Errorf("unknown relation %s", relation)
+	}
+
+	members := []RelationMember{}
+	err := r.db.SelectContext(ctx, &members, query)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("select %s error: %w", relation, err)
+	}
+	return members, nil
+}
+
+// QueryCatalog executes a single SELECT statement written by a client on a read-only connection and returns at most maxRows rows.
+// The query is materialized into a temporary table, because the sqlite driver only honours cancellation of the context while executing statements.
+func (r *CatalogRepo) QueryCatalog(ctx context.Context, query string, maxRows int) (QueryResult, error) {
+	if r.db == nil || r.readOnlyDB == nil {
+		return QueryResult{}, fmt.Errorf("database not yet opened")
+	}
+
+	query, err := selectStatement(query)
+	if err != nil {
+		return QueryResult{}, err
+	}
+
+	conn, err := r.readOnlyDB.Connx(ctx)
+	if err != nil {
+		return QueryResult{}, fmt.Errorf("connect read-only error: %w", err)
+	}
+	defer conn.Close()
+	defer conn.ExecContext(context.Background(), "DROP TABLE IF EXISTS temp.query_result")
+
+	// selectStatement guarantees a single statement without comments whose parentheses balance, so the query cannot
+	// close the sub-query early or comment out the LIMIT: the number of materialized rows stays capped
+	_, err = conn.ExecContext(ctx, fmt.Sprintf("CREATE TEMP TABLE query_result AS SELECT * FROM (\n%s\n) LIMIT %d", query, maxRows+1))
+	if err != nil {
+		if ctx.Err() != nil {
+			return QueryResult{}, fmt.Errorf("query aborted: %w", ctx.Err())
+		}
+		return QueryResult{}, fmt.Errorf("%w: %s", ErrInvalidQuery, err)
+	}
+
+	rows, err := conn.QueryxContext(ctx, "SELECT * FROM temp.query_result")
+	if err != nil {
+		return QueryResult{}, fmt.Errorf("select query result error: %w", err)
+	}
+	defer rows.Close()
+
+	result := QueryResult{Rows: [][]any{}}
+	result.Columns, err = rows.Columns()
+	if err != nil {
+		return QueryResult{}, fmt.Errorf("query result columns error: %w", err)
+	}
+	for rows.Next() {
+		if len(result.Rows) == maxRows {
+			result.Truncated = true
+			break
+		}
+		row, err := rows.SliceScan()
+		if err != nil {
+			return QueryResult{}, fmt.Errorf("scan query result error: %w", err)
+		}
+		for i, value := range row {
+			if bytes, ok := value.([]byte); ok {
+				row[i] = string(bytes)
+			}
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	if rows.Err() != nil {
+		return QueryResult{}, fmt.Errorf("read query result error: %w", rows.Err())
+	}
+	result.RowCount = len(result.Rows)
+
+	return result, nil
+}
+
+// selectStatement returns the query without trailing semicolon when it is a single SELECT (or WITH ... SELECT) statement
+// that can safely be nested as a sub-query. Any other semicolon is rejected, even inside a string literal, because the
+// driver executes every statement of a multi-statement string. Comments, unbalanced parentheses and unterminated
+// literals are rejected because they could escape the sub-query.
+func selectStatement(query string) (string, error) {
+	query = strings.TrimSpace(query)
+	query = strings.TrimSpace(strings.TrimSuffix(query, ";"))
+	if query == "" {
+		return "", fmt.Errorf("%w: empty query", ErrInvalidQuery)
+	}
+	if strings.Contains(query, ";") {
+		return "", fmt.Errorf("%w: only a single statement is allowed", ErrInvalidQuery)
+	}
+	firstWord := strings.ToUpper(strings.Fields(query)[0])
+	if firstWord != "SELECT" && firstWord != "WITH" {
+		return "", fmt.Errorf("%w: only SELECT statements are allowed", ErrInvalidQuery)
+	}
+	err := checkNesting(query)
+	if err != nil {
+		return "", err
+	}
+	return query, nil
+}
+
+// sqlQuotes maps the characters that open a string literal or quoted identifier in SQLite to the ones that close them
+var sqlQuotes = map[rune]rune{'\'': '\'', '"': '"', '`': '`', '[': ']'}
+
+// checkNesting scans the query outside literals and quoted identifiers for comments and unbalanced parentheses
+func checkNesting(query string) error {
+	depth := 0
+	runes := []rune(query)
+	for i := 0; i < len(runes); i++ {
+		if closing, found := sqlQuotes[runes[i]]; found {
+			// a doubled closing quote is an escaped quote, which scans as closing and reopening the literal
+			end := slices.Index(runes[i+1:], closing)
+			if end < 0 {
+				return fmt.Errorf("%w: unterminated literal", ErrInvalidQuery)
+			}
+			i += end + 1
+			continue
+		}
+		switch {
+		case runes[i] == '-' && i+1 < len(runes) && runes[i+1] == '-',
+			runes[i] == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			return fmt.Errorf("%w: comments are not allowed", ErrInvalidQuery)
+		case runes[i] == '(':
+			depth++
+		case runes[i] == ')':
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("%w: unbalanced parentheses", ErrInvalidQuery)
+			}
+		}
+	}
+	if depth != 0 {
+		return fmt.Errorf("%w: unbalanced parentheses", ErrInvalidQuery)
+	}
+	return nil
+}
+
+// GetSchema describes the tables and views of the catalog database
+func (r *CatalogRepo) GetSchema(ctx context.Context) ([]TableSchema, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("database not yet opened")
+	}
+
+	tables := []TableSchema{}
+	err := r.db.Select(&tables, `
+	SELECT 
+		name, type
+	FROM 
+		sqlite_master
+	WHERE 
+		type IN ('table', 'view') AND name NOT LIKE 'sqlite_%'
+	ORDER BY 
+		name`)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("select tables error: %w", err)
+	}
+
+	for i, table := range tables {
+		tables[i].Columns = []ColumnSchema{}
+		err = r.db.Select(&tables[i].Columns, "SELECT name, type FROM pragma_table_info($1) ORDER BY cid", table.Name)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, fmt.Errorf("select columns of %s error: %w", table.Name, err)
+		}
+	}
+
+	return tables, nil
+}
+
 func wildcard(in string) string {
 	if in == "" {
 		return in
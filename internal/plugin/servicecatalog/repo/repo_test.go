@@ -26,8 +26,9 @@ func TestListModulesByComplexity(t *testing.T) {
 	repo, ctx, cleanup := setup(t)
 	defer cleanup()
 
-	modules, err := repo.ListModulesByCompexity(ctx, 5)
+	modules, exists, err := repo.ListModulesByCompexity(ctx, 5, "")
 	assert.NoError(t, err)
+	assert.True(t, exists)
 	assert.Len(t, modules, 5)
 	top5 := lo.Map(modules, func(m Module, _ int) string {
 		return m.ModuleID
@@ -133,20 +134,20 @@ func TestListInterfacesFiltered(t *testing.T) {
 
 }
 
-func TestListInterfacesByComplexity(t *testing.T) {
+func TestListInterfaceMetrics(t *testing.T) {
 	repo, ctx, cleanup := setup(t)
 	defer cleanup()
 
-	interfaces, err := repo.ListInterfacesByComplexity(ctx, 5)
+	interfaces, err := repo.ListInterfaceMetrics(ctx)
 	assert.NoError(t, err)
-	assert.Len(t, interfaces, 5)
-	names := lo.Map(interfaces, func(i Interface, _ int) string { return i.InterfaceID })
-	assert.Equal(t, []string{
-		"ManagementServiceV3",
-		"ManagementServiceV1",
-		"com.adyen.services.postfm.PosTFMService",
-		"com.adyen.services.acm.AcmService",
-		"com.adyen.services.configurationapi.MerchantConfigurationService"}, names)
+	assert.Greater(t, len(interfaces), 100)
+
+	acm, found := lo.Find(interfaces, func(i Interface) bool { return i.InterfaceID == "com.adyen.services.acm.AcmService" })
+	assert.True(t, found)
+	assert.Greater(t, acm.MethodCount, 0)
+	assert.Greater(t, *acm.ConsumerCount, 0)
+	assert.Greater(t, *acm.ConsumingTeamCount, 0)
+	assert.LessOrEqual(t, *acm.ConsumingTeamCount, *acm.ConsumerCount)
 }
 
 func TestGetInterfaceOnID(t *testing.T) {
@@ -278,6 +279,91 @@ func TestListTeams(t *testing.T) {
 	assert.Equal(t, "All ipp-teams", teams[0])
 }
 
+func TestListModulesByComplexityWithProfile(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	modules, exists, err := repo.ListModulesByCompexity(ctx, 10, "coupling")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Len(t, modules, 10)
+	for i := 1; i < len(modules); i++ {
+		assert.GreaterOrEqual(t, modules[i-1].ComplexityScore, modules[i].ComplexityScore)
+	}
+	assert.LessOrEqual(t, modules[0].ComplexityScore, float32(100))
+	assert.NotNil(t, modules[0].FanIn)
+	assert.NotNil(t, modules[0].FanOut)
+
+	_, exists, err = repo.ListModulesByCompexity(ctx, 10, "unknown")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestGetComplexityBreakdown(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	module, exists, err := repo.GetModuleOnID(ctx, "psp")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	breakdown, exists, err := repo.GetComplexityBreakdown(ctx, "psp", "")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "default", breakdown.Profile)
+	assert.InDelta(t, module.ComplexityScore, breakdown.Score, 0.1)
+	assert.Greater(t, breakdown.Percentile, 90.0)
+	assert.GreaterOrEqual(t, breakdown.TeamModuleCount, 1)
+	assert.NotEmpty(t, breakdown.Metrics)
+
+	_, exists, err = repo.GetComplexityBreakdown(ctx, "NonExistingModule", "")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	_, _, err = repo.GetComplexityBreakdown(ctx, "psp", "unknown")
+	assert.Error(t, err)
+}
+
+func TestFilterModules(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	minLineCount := 1000
+	modules, err := repo.FilterModules(ctx, ModuleFilter{
+		Team:         "ipp-payments",
+		MinLineCount: &minLineCount,
+		SortBy:       SortOnLineCount,
+		Descending:   true,
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, modules)
+	for i, module := range modules {
+		assert.Equal(t, "ipp-payments", module.Team)
+		assert.GreaterOrEqual(t, module.LineCount, minLineCount)
+		if i > 0 {
+			assert.GreaterOrEqual(t, modules[i-1].LineCount, module.LineCount)
+		}
+	}
+}
+
+func TestFilterModulesOnComplexity(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	minComplexity := 100.0
+	modules, err := repo.FilterModules(ctx, ModuleFilter{
+		MinComplexity: &minComplexity,
+		SortBy:        SortOnComplexity,
+		Descending:    true,
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, modules)
+	assert.Equal(t, "psp", modules[0].ModuleID)
+	for _, module := range modules {
+		assert.GreaterOrEqual(t, float64(module.ComplexityScore), minComplexity)
+	}
+}
+
 func TestListModulesOfTeams(t *testing.T) {
 	repo, ctx, cleanup := setup(t)
 	defer cleanup()
@@ -290,6 +376,84 @@ func TestListModulesOfTeams(t *testing.T) {
 	assert.Equal(t, "common/cardapplication", modules[0])
 }
 
+func TestGetTeamOnID(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	team, exists, err := repo.GetTeamOnID(ctx, "ipp-payments")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "ipp-payments", team.TeamID)
+	assert.Equal(t, len(team.Modules), team.ModuleCount)
+	assert.Contains(t, team.Modules, "common/cardapplication")
+	assert.Greater(t, team.LineCount, 0)
+	assert.Greater(t, team.FileCount, 0)
+	assert.InDelta(t, team.TotalComplexityScore/float32(team.ModuleCount), team.AverageComplexityScore, 0.01)
+	assert.NotEmpty(t, team.ExposedInterfaces)
+}
+
+func TestGetTeamOnIDNotFound(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	_, exists, err := repo.GetTeamOnID(ctx, "nonexistent")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestQueryCatalog(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	result, err := repo.QueryCatalog(ctx, "SELECT module_id, team FROM module ORDER BY module_id;", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"module_id", "team"}, result.Columns)
+	assert.Equal(t, 1, result.RowCount)
+	assert.Len(t, result.Rows, 1)
+	assert.True(t, result.Truncated)
+}
+
+func TestQueryCatalogRejectsWrites(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	for _, query := range []string{
+		"",
+		"DELETE FROM module",
+		"SELECT 1; DROP TABLE module",
+		"SELECT 1); ATTACH '/tmp/y.sqlite' AS y; SELECT (1",
+		"SELECT * FROM module) /*",
+		"SELECT * FROM module) --",
+		"SELECT * FROM module) UNION SELECT * FROM (SELECT * FROM module",
+		"SELECT * FROM module WHERE name = 'x",
+		"SELECT (1",
+		"WITH m AS (SELECT 1) DELETE FROM module",
+	} {
+		_, err := repo.QueryCatalog(ctx, query, 10)
+		assert.ErrorIs(t, err, ErrInvalidQuery, query)
+	}
+
+	result, err := repo.QueryCatalog(ctx, "SELECT count(*) FROM module", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.RowCount)
+
+	// parentheses and comment markers inside literals are harmless
+	result, err = repo.QueryCatalog(ctx, "SELECT 'a) /* b' AS \"x -- (\", ('it''s') AS y", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"a) /* b", "it's"}, result.Rows[0])
+}
+
+func TestGetSchema(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	tables, err := repo.GetSchema(ctx)
+	assert.NoError(t, err)
+	module, found := lo.Find(tables, func(table TableSchema) bool { return table.Name == "module" })
+	assert.True(t, found)
+	assert.Contains(t, lo.Map(module.Columns, func(column ColumnSchema, _ int) string { return column.Name }), "module_id")
+}
+
 func TestListFlows(t *testing.T) {
 	repo, ctx, cleanup := setup(t)
 	defer cleanup()
@@ -322,6 +486,29 @@ func TestListFlowParticpants(t *testing.T) {
 	assert.Equal(t, []string{"ca", "ca-core", "consumers", "pspdw"}, modules)
 }
 
+func TestGetFlowOnID(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	flow, exists, err := repo.GetFlowOnID(ctx, "CustomerPortals-TransactionSearch")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Len(t, flow.Participants, 4)
+	assert.NotEmpty(t, flow.Teams)
+	for _, edge := range flow.Edges {
+		assert.NotContains(t, flow.EntryPoints, edge.ProviderModuleID)
+	}
+}
+
+func TestGetFlowOnIDNotFound(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	_, exists, err := repo.GetFlowOnID(ctx, "NonExistingFlow")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
 func TestListKinds(t *testing.T) {
 	repo, ctx, cleanup := setup(t)
 	defer cleanup()
@@ -371,6 +558,280 @@ func TestListConsumersOfGradleModule(t *testing.T) {
 		"partner", "partner-jobs/partner-commission-job"}, deps)
 }
 
+func TestGetDependencyGraph(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	graph, exists, err := repo.GetDependencyGraph(ctx, "partner", DirectionBoth, 2)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "partner", graph.RootModuleID)
+	assert.Equal(t, DependencyNode{ModuleID: "partner", Direction: DirectionRoot, Distance: 0}, graph.Nodes[0])
+	assert.Greater(t, len(graph.Nodes), 1)
+	assert.NotEmpty(t, graph.Edges)
+	for _, node := range graph.Nodes {
+		assert.LessOrEqual(t, node.Distance, 2)
+	}
+}
+
+func TestGetDependencyGraphNotFound(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	_, exists, err := repo.GetDependencyGraph(ctx, "Partner", DirectionBoth, 2)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestGetImpactAnalysisOfInterface(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	analysis, exists, err := repo.GetImpactAnalysis(ctx, ImpactSubjectInterface, "com.adyen.services.acm.AcmService", 2)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.GreaterOrEqual(t, len(analysis.AffectedModules), 10)
+	assert.Equal(t, "adyen", analysis.AffectedModules[0].ModuleID)
+	assert.Equal(t, 1, analysis.AffectedModules[0].Distance)
+	assert.NotEmpty(t, analysis.ByTeam)
+}
+
+func TestGetImpactAnalysisOfDatabase(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	analysis, exists, err := repo.GetImpactAnalysis(ctx, ImpactSubjectDatabase, "billing", 1)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.GreaterOrEqual(t, len(analysis.AffectedModules), 10)
+	assert.Equal(t, "airflowjob", analysis.AffectedModules[0].ModuleID)
+}
+
+func TestGetImpactAnalysisNotFound(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	_, exists, err := repo.GetImpactAnalysis(ctx, ImpactSubjectDatabase, "bill", 2)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestListShortestPaths(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	paths, err := repo.ListShortestPaths(ctx, "partner", "psp", 3)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, len(paths), 3)
+	for _, path := range paths {
+		assert.Equal(t, "partner", path.Hops[0].ConsumerModuleID)
+		assert.Equal(t, "psp", path.Hops[len(path.Hops)-1].ProviderModuleID)
+		assert.Equal(t, paths[0].Length, path.Length)
+	}
+}
+
+func TestListShortestPathsNotFound(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	_, err := repo.ListShortestPaths(ctx, "partner", "Backoffice", 3)
+	assert.Equal(t, UnknownModuleError{ModuleID: "Backoffice"}, err)
+}
+
+func TestListDependencyCycles(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	cycles, err := repo.ListDependencyCycles(ctx)
+	assert.NoError(t, err)
+	for _, cycle := range cycles {
+		assert.Greater(t, len(cycle.Modules), 1)
+		assert.GreaterOrEqual(t, len(cycle.Edges), len(cycle.Modules))
+	}
+}
+
+func TestListDependencyEdgesBetween(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	moduleIDs, exists, err := repo.ListParticpantsOfFlow(ctx, "CustomerPortals-TransactionSearch")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	edges, err := repo.ListDependencyEdgesBetween(ctx, moduleIDs)
+	assert.NoError(t, err)
+	for _, edge := range edges {
+		assert.Contains(t, moduleIDs, edge.ConsumerModuleID)
+		assert.Contains(t, moduleIDs, edge.ProviderModuleID)
+	}
+}
+
+func TestGetGradleClosureOfDependencies(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	closure, exists, err := repo.GetGradleClosure(ctx, "partner", DirectionDependencies)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	direct := lo.Filter(closure.Entries, func(e GradleClosureEntry, _ int) bool { return e.Depth == 1 })
+	if assert.NotEmpty(t, direct) {
+		assert.Equal(t, "api/accountmanagement", direct[0].ModuleID)
+		assert.GreaterOrEqual(t, len(closure.Entries), len(direct))
+	}
+}
+
+func TestGetGradleClosureOfConsumers(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	closure, exists, err := repo.GetGradleClosure(ctx, "data-access/repositories/partnercommission", DirectionConsumers)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	if assert.NotEmpty(t, closure.Entries) {
+		assert.Equal(t, "partner", closure.Entries[0].ModuleID)
+		assert.Equal(t, 1, closure.Entries[0].Depth)
+	}
+}
+
+func TestListRelationMembers(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	for _, relation := range []Relation{RelationInterfaceMethods, RelationInterfaceConsumers, RelationDatabaseConsumers, RelationFlowParticipants} {
+		members, err := repo.ListRelationMembers(ctx, relation)
+		assert.NoError(t, err, relation)
+		assert.NotEmpty(t, members, relation)
+	}
+
+	consumers, _, err := repo.ListInterfaceConsumers(ctx, "PartnerTermsResourceV1")
+	assert.NoError(t, err)
+	members, err := repo.ListRelationMembers(ctx, RelationInterfaceConsumers)
+	assert.NoError(t, err)
+	assert.Equal(t, consumers, lo.FilterMap(members, func(member RelationMember, _ int) (string, bool) {
+		return member.MemberID, member.ID == "PartnerTermsResourceV1"
+	}))
+
+	_, err = repo.ListRelationMembers(ctx, Relation("unknown"))
+	assert.Error(t, err)
+}
+
+func TestGetModuleOnIDCoupling(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	// the per-module counts must match the fan-in and fan-out derived from the dependency graph
+	modules, err := repo.(*CatalogRepo).listModuleMetrics(ctx)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, modules)
+	for _, expected := range modules {
+		module, exists, err := repo.GetModuleOnID(ctx, expected.ModuleID)
+		assert.NoError(t, err)
+		assert.True(t, exists)
+		assert.Equal(t, expected.FanIn, module.FanIn, expected.ModuleID)
+		assert.Equal(t, expected.FanOut, module.FanOut, expected.ModuleID)
+	}
+}
+
+func TestGetGradleClosureOfUnknownModule(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	_, exists, err := repo.GetGradleClosure(ctx, "nonexistent_module", DirectionDependencies)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestListJobs(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	jobs, err := repo.ListJobs(ctx, "")
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, len(jobs), 1)
+
+	job, exists, err := repo.GetJobOnID(ctx, jobs[0])
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, jobs[0], job.JobID)
+	assert.GreaterOrEqual(t, len(job.Modules), 1)
+}
+
+func TestGetJobOnIDNotFound(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	_, exists, err := repo.GetJobOnID(ctx, "non-existing-job")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestListTeamDependenciesOfTeam(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	dependencies, exists, err := repo.ListTeamDependencies(ctx, "customer-area")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NotEmpty(t, dependencies.ConsumesFrom)
+	for _, dependency := range dependencies.ConsumesFrom {
+		assert.Equal(t, "customer-area", dependency.ConsumerTeam)
+		assert.NotEqual(t, "customer-area", dependency.ProviderTeam)
+	}
+}
+
+func TestListTeamDependenciesNotFound(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	_, exists, err := repo.ListTeamDependencies(ctx, "partner")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestGetHygieneReport(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	report, err := repo.GetHygieneReport(ctx)
+	assert.NoError(t, err)
+	for _, interfaceID := range report.UnconsumedInterfaces {
+		consumers, exists, err := repo.ListInterfaceConsumers(ctx, interfaceID)
+		assert.NoError(t, err)
+		assert.True(t, exists)
+		assert.Empty(t, consumers)
+	}
+	for _, databaseID := range report.DatabasesWithoutConsumers {
+		consumers, exists, err := repo.ListDatabaseConsumers(ctx, databaseID)
+		assert.NoError(t, err)
+		assert.True(t, exists)
+		assert.Empty(t, consumers)
+	}
+}
+
+func TestGetMethodOnID(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	methods, err := repo.ListMethods(ctx)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, methods)
+
+	method, exists, err := repo.GetMethodOnID(ctx, methods[0])
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, methods[0], method.MethodID)
+	assert.NotEmpty(t, method.Interfaces)
+}
+
+func TestGetMethodOnIDNotFound(t *testing.T) {
+	repo, ctx, cleanup := setup(t)
+	defer cleanup()
+
+	_, exists, err := repo.GetMethodOnID(ctx, "non-existing-method")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
 func setup(t *testing.T) (Cataloger, context.Context, func()) {
 	ctx := context.TODO()
 
@@ -0,0 +1,207 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+)
+
+// SemanticModelStorer defines the interface for persisting the semantic model of the catalog between runs.
+//
+//go:generate go tool mockgen -source=semantic_store.go -destination=mock_semantic_store.go -package=repo SemanticModelStorer
+type SemanticModelStorer interface {
+	Open(ctx context.Context) error
+	Close(ctx context.Context) error
+	GetSemanticModel(ctx context.Context, fingerprint string) (SemanticModel, bool, error)
+	PutSemanticModel(ctx context.Context, model SemanticModel) error
+}
+
+// SemanticModel holds the vectors of a latent semantic model of the texts in the catalog.
+// The fingerprint identifies the texts and settings the model was trained on.
+type SemanticModel struct {
+	Fingerprint string             `db:"fingerprint"`
+	Dimensions  int                `db:"dimensions"`
+	Terms       []SemanticTerm     `db:"-"`
+	Documents   []SemanticDocument `db:"-"`
+}
+
+// SemanticTerm is a term of a semantic model, with its weight and its direction in the concept space
+type SemanticTerm struct {
+	Term   string    `db:"term"`
+	IDF    float64   `db:"idf"`
+	Vector []float32 `db:"-"`
+}
+
+// SemanticDocument is a module or interface of a semantic model, with its position in the concept space
+type SemanticDocument struct {
+	Kind   string    `db:"kind"`
+	ID     string    `db:"id"`
+	Vector []float32 `db:"-"`
+}
+
+// NewSemanticModelStore creates a store that keeps the semantic model in its own SQLite file, next to the catalog
+// database rather than inside it, so the catalog database is never written to.
+func NewSemanticModelStore(filename string) SemanticModelStorer {
+	return &SemanticModelStore{
+		filename: filename,
+	}
+}
+
+// SemanticModelStore is an implementation of SemanticModelStorer using a SQLite database.
+type SemanticModelStore struct {
+	filename string
+	db       *sqlx.DB
+}
+
+// Open opens the database connection, creating the file and its tables when they do not exist yet.
+func (s *SemanticModelStore) Open(ctx context.Context) error {
+	log.Printf("Opening semantic model database: %s", s.filename)
+
+	if s.db != nil {
+		// already opened
+		return nil
+	}
+
+	db, err := sqlx.Connect("sqlite", s.filename)
+	if err != nil {
+		return fmt.Errorf("connect error: %w", err)
+	}
+
+	for _, statement := range []string{
+		"CREATE TABLE IF NOT EXISTS semantic_model (fingerprint TEXT NOT NULL, dimensions INTEGER NOT NULL)",
+		"CREATE TABLE IF NOT EXISTS semantic_term (term TEXT PRIMARY KEY, idf REAL NOT NULL, vector BLOB NOT NULL)",
+		"CREATE TABLE IF NOT EXISTS semantic_document (kind TEXT NOT NULL, id TEXT NOT NULL, vector BLOB NOT NULL, PRIMARY KEY (kind, id))",
+	} {
+		_, err = db.ExecContext(ctx, statement)
+		if err != nil {
+			db.Close()
+			return fmt.Errorf("create semantic model tables error: %w", err)
+		}
+	}
+
+	s.db = db
+	return nil
+}
+
+// Close closes the database connection.
+func (s *SemanticModelStore) Close(ctx context.Context) error {
+	if s.db == nil {
+		// already closed
+		return nil
+	}
+	return s.db.Close()
+}
+
+// GetSemanticModel returns the stored semantic model, when it was trained on the texts with the given fingerprint.
+func (s *SemanticModelStore) GetSemanticModel(ctx context.Context, fingerprint string) (SemanticModel, bool, error) {
+	if s.db == nil {
+		return SemanticModel{}, false, fmt.Errorf("database not yet opened")
+	}
+
+	model := SemanticModel{}
+	err := s.db.GetContext(ctx, &model, "SELECT fingerprint, dimensions FROM semantic_model WHERE fingerprint = $1", fingerprint)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return SemanticModel{}, false, nil
+		}
+		return SemanticModel{}, false, fmt.Errorf("select semantic model error: %w", err)
+	}
+
+	type vectorRow struct {
+		Term   string  `db:"term"`
+		IDF    float64 `db:"idf"`
+		Kind   string  `db:"kind"`
+		ID     string  `db:"id"`
+		Vector []byte  `db:"vector"`
+	}
+
+	termRows := []vectorRow{}
+	err = s.db.SelectContext(ctx, &termRows, "SELECT term, idf, vector FROM semantic_term ORDER BY term")
+	if err != nil {
+		return SemanticModel{}, false, fmt.Errorf("select semantic terms error: %w", err)
+	}
+	model.Terms = lo.Map(termRows, func(row vectorRow, _ int) SemanticTerm {
+		return SemanticTerm{Term: row.Term, IDF: row.IDF, Vector: decodeVector(row.Vector)}
+	})
+
+	documentRows := []vectorRow{}
+	err = s.db.SelectContext(ctx, &documentRows, "SELECT kind, id, vector FROM semantic_document ORDER BY rowid")
+	if err != nil {
+		return SemanticModel{}, false, fmt.Errorf("select semantic documents error: %w", err)
+	}
+	model.Documents = lo.Map(documentRows, func(row vectorRow, _ int) SemanticDocument {
+		return SemanticDocument{Kind: row.Kind, ID: row.ID, Vector: decodeVector(row.Vector)}
+	})
+
+	return model, true, nil
+}
+
+// PutSemanticModel stores the semantic model, replacing any previous model.
+func (s *SemanticModelStore) PutSemanticModel(ctx context.Context, model SemanticModel) error {
+	if s.db == nil {
+		return fmt.Errorf("database not yet opened")
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin semantic model transaction error: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, statement := range []string{
+		"DELETE FROM semantic_model",
+		"DELETE FROM semantic_term",
+		"DELETE FROM semantic_document",
+	} {
+		_, err = tx.ExecContext(ctx, statement)
+		if err != nil {
+			return fmt.Errorf("clear semantic model tables error: %w", err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO semantic_model (fingerprint, dimensions) VALUES ($1, $2)", model.Fingerprint, model.Dimensions)
+	if err != nil {
+		return fmt.Errorf("insert semantic model error: %w", err)
+	}
+	for _, term := range model.Terms {
+		_, err = tx.ExecContext(ctx, "INSERT INTO semantic_term (term, idf, vector) VALUES ($1, $2, $3)", term.Term, term.IDF, encodeVector(term.Vector))
+		if err != nil {
+			return fmt.Errorf("insert semantic term %s error: %w", term.Term, err)
+		}
+	}
+	for _, document := range model.Documents {
+		_, err = tx.ExecContext(ctx, "INSERT INTO semantic_document (kind, id, vector) VALUES ($1, $2, $3)", document.Kind, document.ID, encodeVector(document.Vector))
+		if err != nil {
+			return fmt.Errorf("insert semantic document %s error: %w", document.ID, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("commit semantic model error: %w", err)
+	}
+	return nil
+}
+
+// encodeVector stores a vector as little-endian float32 values
+func encodeVector(vector []float32) []byte {
+	encoded := make([]byte, 0, 4*len(vector))
+	for _, value := range vector {
+		encoded = binary.LittleEndian.AppendUint32(encoded, math.Float32bits(value))
+	}
+	return encoded
+}
+
+func decodeVector(encoded []byte) []float32 {
+	vector := make([]float32, len(encoded)/4)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(encoded[4*i:]))
+	}
+	return vector
+}
@@ -0,0 +1,48 @@
+package repo
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemanticModelStore(t *testing.T) {
+	ctx := context.TODO()
+
+	filename := filepath.Join(t.TempDir(), "semantic-model.sqlite")
+	store := NewSemanticModelStore(filename)
+	err := store.Open(ctx)
+	assert.NoError(t, err)
+
+	_, exists, err := store.GetSemanticModel(ctx, "fingerprint1")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	model := SemanticModel{
+		Fingerprint: "fingerprint1",
+		Dimensions:  2,
+		Terms:       []SemanticTerm{{Term: "chargeback", IDF: 1.5, Vector: []float32{0.5, -0.25}}},
+		Documents:   []SemanticDocument{{Kind: "module", ID: "disputes", Vector: []float32{1, 0}}},
+	}
+	err = store.PutSemanticModel(ctx, model)
+	assert.NoError(t, err)
+
+	_, exists, err = store.GetSemanticModel(ctx, "fingerprint2")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	// survives a restart
+	err = store.Close(ctx)
+	assert.NoError(t, err)
+	store = NewSemanticModelStore(filename)
+	err = store.Open(ctx)
+	assert.NoError(t, err)
+	defer store.Close(ctx)
+
+	stored, exists, err := store.GetSemanticModel(ctx, "fingerprint1")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, model, stored)
+}
@@ -0,0 +1,37 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+)
+
+// NewSchemaResource returns the MCP resource contract and handler for the schema of the catalog database.
+func (h *mcpHandler) schemaResource() server.ServerResource {
+	return server.ServerResource{
+		Resource: mcp.NewResource(
+			"catalog://schema",
+			"Tables and columns of the catalog database, to write queries for query_catalog",
+			mcp.WithMIMEType("application/json"),
+		),
+		Handler: func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			// call business logic
+			tables, err := h.repo.GetSchema(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error getting schema: %s", err)
+			}
+
+			return []mcp.ResourceContents{
+				mcp.TextResourceContents{
+					URI:      request.Params.URI,
+					MIMEType: "application/json",
+					Text:     resp.Success(ctx, tables),
+				},
+			}, nil
+		},
+	}
+}
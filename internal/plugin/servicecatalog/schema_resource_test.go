@@ -0,0 +1,37 @@
+package servicecatalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+func TestSchemaResource(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := repo.NewMockCataloger(ctrl)
+	repository.EXPECT().GetSchema(gomock.Any()).Return([]repo.TableSchema{
+		{Name: "module", Type: "table", Columns: []repo.ColumnSchema{{Name: "module_id", Type: "TEXT"}, {Name: "team", Type: "TEXT"}}},
+	}, nil)
+
+	resource := NewMCPHandler(repository, nil).schemaResource()
+
+	// When
+	request := mcp.ReadResourceRequest{}
+	request.Params.URI = "catalog://schema"
+	contents, err := resource.Handler(context.Background(), request)
+
+	// Then
+	assert.NoError(t, err)
+	text := contents[0].(mcp.TextResourceContents).Text
+	assert.Contains(t, text, `"name": "module"`)
+	assert.Contains(t, text, `"name": "module_id"`)
+	assert.Contains(t, text, `"type": "TEXT"`)
+}
@@ -0,0 +1,91 @@
+package search
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
+)
+
+// Documents holds the searchable texts of the catalog, shared by the text and the semantic index so the catalog and
+// all OpenAPI specifications are only read once.
+type Documents struct {
+	cataloger repo.Cataloger
+	specs     spec.Loader
+	mutex     sync.Mutex
+	collected []textDocument
+}
+
+// NewDocuments creates the searchable texts of the module descriptions and specifications, the interface descriptions
+// and the summaries of the OpenAPI operations that the loader can read.
+// The texts are collected on first use, because reading all OpenAPI specifications takes a while.
+func NewDocuments(cataloger repo.Cataloger, specs spec.Loader) *Documents {
+	return &Documents{
+		cataloger: cataloger,
+		specs:     specs,
+	}
+}
+
+func (d *Documents) list(ctx context.Context) ([]textDocument, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.collected == nil {
+		documents, err := collectDocuments(ctx, d.cataloger, d.specs)
+		if err != nil {
+			return nil, err
+		}
+		d.collected = documents
+	}
+	return d.collected, nil
+}
+
+// collectDocuments returns the texts of the catalog that are searchable: one document per field of a module or interface
+func collectDocuments(ctx context.Context, cataloger repo.Cataloger, specs spec.Loader) ([]textDocument, error) {
+	modules, err := cataloger.ListModules(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("error listing modules for search: %w", err)
+	}
+	interfaces, err := cataloger.ListInterfaces(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("error listing interfaces for search: %w", err)
+	}
+
+	documents := []textDocument{}
+	for _, module := range modules {
+		documents = append(documents,
+			textDocument{kind: KindModule, id: module.ModuleID, field: FieldDescription, text: module.Description},
+			textDocument{kind: KindModule, id: module.ModuleID, field: FieldSpecification, text: module.Spec},
+		)
+	}
+	for _, iface := range interfaces {
+		documents = append(documents,
+			textDocument{kind: KindInterface, id: iface.InterfaceID, field: FieldDescription, text: iface.Description})
+		if iface.OpenAPISpecs == nil || *iface.OpenAPISpecs == "" {
+			continue
+		}
+		// Unreadable specifications just leave out their summaries
+		data, exists, err := specs.Load(ctx, *iface.OpenAPISpecs)
+		if err != nil || !exists {
+			continue
+		}
+		parsed, err := spec.ParseOpenAPI(data)
+		if err != nil {
+			continue
+		}
+		for _, operation := range parsed.Operations {
+			documents = append(documents, textDocument{
+				kind:      KindInterface,
+				id:        iface.InterfaceID,
+				field:     FieldOperationSummary,
+				operation: cmp.Or(operation.OperationID, operation.Key()),
+				text:      operation.Summary,
+			})
+		}
+	}
+
+	return documents, nil
+}
@@ -0,0 +1,32 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
+)
+
+func TestDocuments_CollectedOnceForBothIndexes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	cataloger := repo.NewMockCataloger(ctrl)
+	cataloger.EXPECT().ListModules(gomock.Any(), "").Return(semanticTestModules, nil).Times(1)
+	cataloger.EXPECT().ListInterfaces(gomock.Any(), "").Return(semanticTestInterfaces, nil).Times(1)
+
+	documents := NewDocuments(cataloger, spec.NewMockLoader(ctrl))
+	text := NewTextIndex(documents)
+	semantic := NewSemanticIndex(documents, nil)
+
+	textHits, err := text.SearchText(context.Background(), "chargebacks", "", 10)
+	assert.NoError(t, err)
+	assert.Len(t, textHits, 2)
+
+	semanticHits, err := semantic.SearchSemantic(context.Background(), "sunny weather", KindModule, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, "weather", semanticHits[0].ID)
+}
@@ -1,7 +1,14 @@
 package search
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/rs/zerolog/log"
 	"github.com/sahilm/fuzzy"
@@ -17,18 +24,87 @@ type Index interface {
 	Search(ctx context.Context, keyword string, limit int) Result
 }
 
+// Category is the type of entity a search hit refers to
+type Category string
+
+// Categories of entities that can be searched
+const (
+	CategoryModule    Category = "module"
+	CategoryTeam      Category = "team"
+	CategoryInterface Category = "interface"
+	CategoryDatabase  Category = "database"
+	CategoryFlow      Category = "flow"
+	CategoryMethod    Category = "method"
+	CategoryKind      Category = "kind"
+	CategoryJob       Category = "job"
+)
+
+// Categories lists all categories, in the order used to break ties between equally scored hits
+var Categories = []Category{
+	CategoryModule,
+	CategoryTeam,
+	CategoryInterface,
+	CategoryDatabase,
+	CategoryFlow,
+	CategoryMethod,
+	CategoryKind,
+	CategoryJob,
+}
+
+// Weights multiply the match scores per category, to prefer some types of entities over others.
+// A weight of zero leaves a category out of the ranked hits.
+type Weights map[Category]float64
+
+// DefaultWeights ranks all categories on their match score alone
+func DefaultWeights() Weights {
+	weights := Weights{}
+	for _, category := range Categories {
+		weights[category] = 1
+	}
+	return weights
+}
+
+// ParseWeights parses weights like "module=1.5,kind=0.5". Categories that are not mentioned keep their default weight.
+func ParseWeights(value string) (Weights, error) {
+	weights := DefaultWeights()
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, number, found := strings.Cut(part, "=")
+		category := Category(strings.TrimSpace(name))
+		if !found || !slices.Contains(Categories, category) {
+			return nil, fmt.Errorf("invalid search weight %s: use <category>=<weight> with category one of %v", part, Categories)
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid search weight %s: weight must be a number of at least 0", part)
+		}
+		weights[category] = weight
+	}
+	return weights, nil
+}
+
+// Option configures optional behaviour of the search index.
+type Option func(idx *searchIndex)
+
+// WithWeights configures the weights of the categories. Categories without a weight keep their default weight.
+func WithWeights(weights Weights) Option {
+	return func(idx *searchIndex) {
+		for category, weight := range weights {
+			idx.weights[category] = weight
+		}
+	}
+}
+
 type searchIndex struct {
-	Modules    []string
-	Teams      []string
-	Interfaces []string
-	Databases  []string
-	Flows      []string
-	Methods    []string
-	Kinds      []string
+	entries map[Category][]string
+	weights Weights
 }
 
 // NewSearchIndex creates a new search index.
-func NewSearchIndex(ctx context.Context, cataloger repo.Cataloger) Index {
+func NewSearchIndex(ctx context.Context, cataloger repo.Cataloger, options ...Option) Index {
 
 	modules, err := cataloger.ListModules(ctx, "")
 	if err != nil {
@@ -65,22 +141,35 @@ func NewSearchIndex(ctx context.Context, cataloger repo.Cataloger) Index {
 		log.Error().Err(err).Msg("Error listing kinds for search index")
 	}
 
-	return &searchIndex{
-		Modules: lo.Map(modules, func(m repo.Module, index int) string {
-			return m.ModuleID
-		}),
-		Interfaces: lo.Map(interfaces, func(m repo.Interface, index int) string {
-			return m.InterfaceID
-		}),
-		Teams:     teams,
-		Databases: databases,
-		Flows:     flows,
-		Methods:   methods,
-		Kinds:     kinds,
+	jobs, err := cataloger.ListJobs(ctx, "")
+	if err != nil {
+		log.Error().Err(err).Msg("Error listing jobs for search index")
+	}
+
+	idx := &searchIndex{
+		entries: map[Category][]string{
+			CategoryModule: lo.Map(modules, func(m repo.Module, index int) string {
+				return m.ModuleID
+			}),
+			CategoryInterface: lo.Map(interfaces, func(m repo.Interface, index int) string {
+				return m.InterfaceID
+			}),
+			CategoryTeam:     teams,
+			CategoryDatabase: databases,
+			CategoryFlow:     flows,
+			CategoryMethod:   methods,
+			CategoryKind:     kinds,
+			CategoryJob:      jobs,
+		},
+		weights: DefaultWeights(),
+	}
+	for _, option := range options {
+		option(idx)
 	}
+	return idx
 }
 
-// Result represents the search results.
+// Result represents the search results: the best matches per category and the best matches of all categories together.
 type Result struct {
 	Modules    []string
 	Teams      []string
@@ -89,29 +178,100 @@ type Result struct {
 	Flows      []string
 	Methods    []string
 	Kinds      []string
+	Jobs       []string
+	Hits       []Hit `json:"hits"`
 }
 
-const flowSearchLimitMultiplier = 2
+// Hit is an entity matching the keyword, best match first
+type Hit struct {
+	Category      Category `json:"category"`
+	ID            string   `json:"id"`
+	Score         float64  `json:"score"`         // relative to an exact match (1), multiplied by the weight of the category
+	MatchedRanges []Range  `json:"matchedRanges"` // the characters of the ID that match the keyword
+}
 
+// Range is a half-open range [Start, End) of character positions
+type Range struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// Search returns up to limit matches per category, plus up to limit hits ranked across all categories.
 func (idx *searchIndex) Search(ctx context.Context, keyword string, limit int) Result {
-	return Result{
-		Modules:    matchesToSlice(fuzzy.Find(keyword, idx.Modules), limit),
-		Teams:      matchesToSlice(fuzzy.Find(keyword, idx.Teams), limit),
-		Interfaces: matchesToSlice(fuzzy.Find(keyword, idx.Interfaces), limit),
-		Databases:  matchesToSlice(fuzzy.Find(keyword, idx.Databases), limit),
-		Flows:      matchesToSlice(fuzzy.Find(keyword, idx.Flows), limit*flowSearchLimitMultiplier),
-		Methods:    matchesToSlice(fuzzy.Find(keyword, idx.Methods), limit),
-		Kinds:      matchesToSlice(fuzzy.Find(keyword, idx.Kinds), limit*4),
+	result := Result{Hits: []Hit{}}
+	perCategory := map[Category][]string{}
+	// fuzzy scores grow with the length of the keyword: the score of an exact match makes them comparable
+	exactScore := 1.0
+	if exact := fuzzy.Find(keyword, []string{keyword}); len(exact) > 0 && exact[0].Score > 0 {
+		exactScore = float64(exact[0].Score)
 	}
+	for _, category := range Categories {
+		matches := lo.Filter(fuzzy.Find(keyword, idx.entries[category]), func(item fuzzy.Match, index int) bool {
+			return item.Score > 0
+		})
+		perCategory[category] = matchesToSlice(matches, limit)
+
+		weight := idx.weights[category]
+		if weight <= 0 {
+			continue
+		}
+		for _, match := range matches {
+			result.Hits = append(result.Hits, Hit{
+				Category:      category,
+				ID:            match.Str,
+				Score:         math.Round(relevance(keyword, match.Str, float64(match.Score)/exactScore)*weight*1000) / 1000,
+				MatchedRanges: matchedRanges(match.Str, match.MatchedIndexes),
+			})
+		}
+	}
+
+	result.Modules = perCategory[CategoryModule]
+	result.Teams = perCategory[CategoryTeam]
+	result.Interfaces = perCategory[CategoryInterface]
+	result.Databases = perCategory[CategoryDatabase]
+	result.Flows = perCategory[CategoryFlow]
+	result.Methods = perCategory[CategoryMethod]
+	result.Kinds = perCategory[CategoryKind]
+	result.Jobs = perCategory[CategoryJob]
+
+	// stable: equal scores keep the order of the categories and of the fuzzy matches
+	slices.SortStableFunc(result.Hits, func(a, b Hit) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+	result.Hits = result.Hits[:min(len(result.Hits), limit)]
+
+	return result
 }
 
 func matchesToSlice(matches fuzzy.Matches, limit int) []string {
-	filtered := lo.Filter(matches, func(item fuzzy.Match, index int) bool {
-		return item.Score > 0
-	})
-	slice := lo.Map(filtered, func(item fuzzy.Match, index int) string {
+	slice := lo.Map(matches, func(item fuzzy.Match, index int) string {
 		return item.Str
 	})
-	// Limit to top 5 per category
 	return slice[0:min(len(slice), limit)]
 }
+
+// maximumPartialScore keeps partial matches below an exact match: camel-case and prefix bonuses can give an acronym
+// like KnowYourCustomer a higher fuzzy score for "kyc" than kyc itself
+const maximumPartialScore = 0.999
+
+// relevance is 1 for an exact (case-insensitive) match and the normalized fuzzy score, at most maximumPartialScore, otherwise
+func relevance(keyword string, id string, normalizedScore float64) float64 {
+	if strings.EqualFold(keyword, id) {
+		return 1
+	}
+	return min(normalizedScore, maximumPartialScore)
+}
+
+// matchedRanges combines the matched byte indexes of a fuzzy match into ranges of character positions
+func matchedRanges(value string, byteIndexes []int) []Range {
+	ranges := []Range{}
+	for _, byteIndex := range byteIndexes {
+		position := utf8.RuneCountInString(value[:byteIndex])
+		if len(ranges) > 0 && ranges[len(ranges)-1].End == position {
+			ranges[len(ranges)-1].End++
+			continue
+		}
+		ranges = append(ranges, Range{Start: position, End: position + 1})
+	}
+	return ranges
+}
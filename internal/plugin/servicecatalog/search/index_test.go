@@ -23,6 +23,18 @@ func TestSearchIndex_Search(t *testing.T) {
 	assert.NoError(t, err)
 	t.Logf("Search result:\n %v", string(jsonResult))
 
+	// Matching jobs vary between catalog generations: only bound their number
+	assert.LessOrEqual(t, len(result.Jobs), 5)
+	result.Jobs = nil
+
+	// The exact match ranks first of all categories
+	if assert.Len(t, result.Hits, 5) {
+		assert.Equal(t, CategoryModule, result.Hits[0].Category)
+		assert.Equal(t, "partner", result.Hits[0].ID)
+		assert.Equal(t, []Range{{Start: 0, End: 7}}, result.Hits[0].MatchedRanges)
+	}
+	result.Hits = nil
+
 	assert.Equal(t, Result{
 		Modules: []string{
 			"partner",
@@ -57,6 +69,86 @@ func TestSearchIndex_Search(t *testing.T) {
 
 }
 
+func newTestIndex(options ...Option) Index {
+	idx := &searchIndex{
+		entries: map[Category][]string{
+			CategoryModule:   {"partner", "partner-jobs", "payments"},
+			CategoryTeam:     {"partner-experience"},
+			CategoryDatabase: {"partner"},
+		},
+		weights: DefaultWeights(),
+	}
+	for _, option := range options {
+		option(idx)
+	}
+	return idx
+}
+
+func TestSearchIndex_RankedHits(t *testing.T) {
+	result := newTestIndex().Search(context.TODO(), "partner", 3)
+
+	assert.Equal(t, []Hit{
+		{Category: CategoryModule, ID: "partner", Score: 1, MatchedRanges: []Range{{Start: 0, End: 7}}},
+		{Category: CategoryDatabase, ID: "partner", Score: 1, MatchedRanges: []Range{{Start: 0, End: 7}}},
+		{Category: CategoryModule, ID: "partner-jobs", Score: 0.997, MatchedRanges: []Range{{Start: 0, End: 7}}},
+	}, result.Hits)
+	assert.Equal(t, []string{"partner", "partner-jobs"}, result.Modules)
+	assert.Equal(t, []string{"partner-experience"}, result.Teams)
+}
+
+func TestSearchIndex_ExactMatchFirst(t *testing.T) {
+	idx := &searchIndex{
+		entries: map[Category][]string{
+			CategoryModule:    {"KnowYourCustomer"},
+			CategoryInterface: {"KYC"},
+		},
+		weights: DefaultWeights(),
+	}
+
+	result := idx.Search(context.TODO(), "kyc", 10)
+
+	// the acronym gets camel-case bonuses that would otherwise lift it above the exact match
+	assert.Equal(t, []Hit{
+		{Category: CategoryInterface, ID: "KYC", Score: 1, MatchedRanges: []Range{{Start: 0, End: 3}}},
+		{Category: CategoryModule, ID: "KnowYourCustomer", Score: maximumPartialScore, MatchedRanges: []Range{{Start: 0, End: 1}, {Start: 4, End: 5}, {Start: 8, End: 9}}},
+	}, result.Hits)
+}
+
+func TestSearchIndex_Weights(t *testing.T) {
+	result := newTestIndex(WithWeights(Weights{CategoryModule: 0, CategoryTeam: 2})).Search(context.TODO(), "partner", 10)
+
+	assert.Equal(t, []Hit{
+		{Category: CategoryTeam, ID: "partner-experience", Score: 1.988, MatchedRanges: []Range{{Start: 0, End: 7}}},
+		{Category: CategoryDatabase, ID: "partner", Score: 1, MatchedRanges: []Range{{Start: 0, End: 7}}},
+	}, result.Hits)
+	// weights only affect the ranked hits
+	assert.Equal(t, []string{"partner", "partner-jobs"}, result.Modules)
+}
+
+func TestParseWeights(t *testing.T) {
+	weights, err := ParseWeights("module=1.5, kind=0")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, weights[CategoryModule])
+	assert.Equal(t, 0.0, weights[CategoryKind])
+	assert.Equal(t, 1.0, weights[CategoryTeam])
+
+	weights, err = ParseWeights("")
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultWeights(), weights)
+
+	_, err = ParseWeights("slo=1")
+	assert.ErrorContains(t, err, "invalid search weight slo=1")
+
+	_, err = ParseWeights("module=-1")
+	assert.ErrorContains(t, err, "invalid search weight module=-1")
+}
+
+func TestMatchedRanges(t *testing.T) {
+	assert.Equal(t, []Range{{Start: 7, End: 14}}, matchedRanges("common/partner", []int{7, 8, 9, 10, 11, 12, 13}))
+	assert.Equal(t, []Range{{Start: 0, End: 1}, {Start: 2, End: 4}}, matchedRanges("ébcd", []int{0, 3, 4}))
+	assert.Equal(t, []Range{}, matchedRanges("abc", nil))
+}
+
 func setup(t *testing.T) (repo.Cataloger, context.Context, func()) {
 	ctx := context.TODO()
 
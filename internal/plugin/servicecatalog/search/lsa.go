@@ -0,0 +1,224 @@
+package search
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sort"
+)
+
+// Settings of the truncated singular value decomposition. Fixed seeds keep models reproducible.
+const (
+	oversampling    = 10
+	powerIterations = 2
+	randomSeed      = 20240601
+)
+
+// sparseVector holds the non-zero values of a column of the term-document matrix, ordered on index
+type sparseVector struct {
+	indices []int
+	values  []float64
+}
+
+// leftSingularVectors returns the (at most) k most significant left singular vectors of the rows × len(columns) matrix,
+// as a rows × k matrix. It uses randomized subspace iteration, so only products with the sparse matrix are needed.
+func leftSingularVectors(columns []sparseVector, rows int, k int) [][]float64 {
+	width := min(k+oversampling, len(columns), rows)
+	if width == 0 {
+		return newMatrix(rows, 0)
+	}
+
+	random := rand.New(rand.NewPCG(randomSeed, randomSeed))
+	q := newMatrix(len(columns), width)
+	for i := range q {
+		for j := range q[i] {
+			q[i][j] = random.NormFloat64()
+		}
+	}
+	for range powerIterations {
+		y := multiply(columns, rows, q)
+		orthonormalize(y)
+		q = multiplyTransposed(columns, y)
+		orthonormalize(q)
+	}
+
+	// basis of the range of the matrix and the decomposition of the matrix projected on it
+	basis := multiply(columns, rows, q)
+	orthonormalize(basis)
+	projected := multiplyTransposed(columns, basis)
+	eigenvalues, eigenvectors := symmetricEigen(gram(projected))
+
+	order := make([]int, width)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return eigenvalues[order[a]] > eigenvalues[order[b]]
+	})
+	// leave out directions without variance, e.g. when the matrix has a lower rank than k
+	order = slices.DeleteFunc(order, func(i int) bool {
+		return eigenvalues[i] <= 1e-10
+	})
+	order = order[:min(len(order), k)]
+
+	vectors := newMatrix(rows, len(order))
+	for r := range vectors {
+		for j, e := range order {
+			for m := range width {
+				vectors[r][j] += basis[r][m] * eigenvectors[m][e]
+			}
+		}
+	}
+	return vectors
+}
+
+func newMatrix(rows, columns int) [][]float64 {
+	matrix := make([][]float64, rows)
+	for i := range matrix {
+		matrix[i] = make([]float64, columns)
+	}
+	return matrix
+}
+
+// multiply returns A·Q for the sparse rows × len(columns) matrix A
+func multiply(columns []sparseVector, rows int, q [][]float64) [][]float64 {
+	result := newMatrix(rows, len(q[0]))
+	for c, column := range columns {
+		for i, row := range column.indices {
+			for j, value := range q[c] {
+				result[row][j] += column.values[i] * value
+			}
+		}
+	}
+	return result
+}
+
+// multiplyTransposed returns Aᵀ·Y for the sparse matrix A
+func multiplyTransposed(columns []sparseVector, y [][]float64) [][]float64 {
+	result := newMatrix(len(columns), len(y[0]))
+	for c, column := range columns {
+		for i, row := range column.indices {
+			for j, value := range y[row] {
+				result[c][j] += column.values[i] * value
+			}
+		}
+	}
+	return result
+}
+
+// gram returns Mᵀ·M
+func gram(m [][]float64) [][]float64 {
+	width := len(m[0])
+	result := newMatrix(width, width)
+	for _, row := range m {
+		for i := range width {
+			for j := i; j < width; j++ {
+				result[i][j] += row[i] * row[j]
+			}
+		}
+	}
+	for i := range width {
+		for j := range i {
+			result[i][j] = result[j][i]
+		}
+	}
+	return result
+}
+
+// orthonormalize makes the columns of the matrix orthonormal in place, with modified Gram-Schmidt.
+// Columns that depend on the previous ones become zero.
+func orthonormalize(m [][]float64) {
+	// work on contiguous columns, the matrices are tall and narrow
+	columns := newMatrix(len(m[0]), len(m))
+	for r, row := range m {
+		for j, value := range row {
+			columns[j][r] = value
+		}
+	}
+
+	for j, column := range columns {
+		for _, previous := range columns[:j] {
+			dot := 0.0
+			for r, value := range column {
+				dot += value * previous[r]
+			}
+			for r := range column {
+				column[r] -= dot * previous[r]
+			}
+		}
+		norm := 0.0
+		for _, value := range column {
+			norm += value * value
+		}
+		norm = math.Sqrt(norm)
+		for r := range column {
+			if norm < 1e-12 {
+				column[r] = 0
+			} else {
+				column[r] /= norm
+			}
+		}
+	}
+
+	for r, row := range m {
+		for j := range row {
+			row[j] = columns[j][r]
+		}
+	}
+}
+
+// symmetricEigen returns the eigenvalues and eigenvectors (as columns) of a symmetric matrix, with the cyclic Jacobi method.
+// The matrix is overwritten.
+func symmetricEigen(a [][]float64) ([]float64, [][]float64) {
+	n := len(a)
+	vectors := newMatrix(n, n)
+	for i := range n {
+		vectors[i][i] = 1
+	}
+
+	for range 100 {
+		offDiagonal := 0.0
+		for p := range n {
+			for q := p + 1; q < n; q++ {
+				offDiagonal += a[p][q] * a[p][q]
+			}
+		}
+		if offDiagonal < 1e-24 {
+			break
+		}
+
+		for p := range n {
+			for q := p + 1; q < n; q++ {
+				if a[p][q] == 0 {
+					continue
+				}
+				// rotation that makes a[p][q] zero
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := math.Copysign(1, theta) / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+				for k := range n {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p] = c*akp - s*akq
+					a[k][q] = s*akp + c*akq
+				}
+				for k := range n {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k] = c*apk - s*aqk
+					a[q][k] = s*apk + c*aqk
+				}
+				for k := range n {
+					vkp, vkq := vectors[k][p], vectors[k][q]
+					vectors[k][p] = c*vkp - s*vkq
+					vectors[k][q] = s*vkp + c*vkq
+				}
+			}
+		}
+	}
+
+	values := make([]float64, n)
+	for i := range n {
+		values[i] = a[i][i]
+	}
+	return values, vectors
+}
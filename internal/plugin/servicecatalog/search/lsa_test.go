@@ -0,0 +1,52 @@
+package search
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSymmetricEigen(t *testing.T) {
+	matrix := [][]float64{
+		{4, 1, 2},
+		{1, 3, 0},
+		{2, 0, 5},
+	}
+	values, vectors := symmetricEigen([][]float64{
+		{4, 1, 2},
+		{1, 3, 0},
+		{2, 0, 5},
+	})
+
+	// every column v with value λ satisfies M·v = λ·v
+	for e, value := range values {
+		for row := range matrix {
+			product := 0.0
+			for k := range matrix {
+				product += matrix[row][k] * vectors[k][e]
+			}
+			assert.InDelta(t, value*vectors[row][e], product, 1e-9)
+		}
+	}
+	assert.InDelta(t, 12.0, values[0]+values[1]+values[2], 1e-9) // trace
+}
+
+func TestLeftSingularVectors(t *testing.T) {
+	// 3 terms × 3 documents, rank 2: the third document equals the first
+	columns := []sparseVector{
+		{indices: []int{0, 1}, values: []float64{1, 1}},
+		{indices: []int{2}, values: []float64{1}},
+		{indices: []int{0, 1}, values: []float64{1, 1}},
+	}
+
+	vectors := leftSingularVectors(columns, 3, 5)
+
+	assert.Len(t, vectors, 3)
+	assert.Len(t, vectors[0], 2) // rank limits the dimensions
+	// strongest direction is the repeated pair of terms, the second one the third term
+	assert.InDelta(t, 1/math.Sqrt2, math.Abs(vectors[0][0]), 1e-9)
+	assert.InDelta(t, 1/math.Sqrt2, math.Abs(vectors[1][0]), 1e-9)
+	assert.InDelta(t, 0, vectors[2][0], 1e-9)
+	assert.InDelta(t, 1, math.Abs(vectors[2][1]), 1e-9)
+}
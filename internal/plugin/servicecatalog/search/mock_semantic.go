@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: semantic.go
+//
+// Generated by this command:
+//
+//	mockgen -source=semantic.go -destination=mock_semantic.go -package=search SemanticIndex
+//
+
+// Package search is a generated GoMock package.
+package search
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSemanticIndex is a mock of SemanticIndex interface.
+type MockSemanticIndex struct {
+	ctrl     *gomock.Controller
+	recorder *MockSemanticIndexMockRecorder
+	isgomock struct{}
+}
+
+// MockSemanticIndexMockRecorder is the mock recorder for MockSemanticIndex.
+type MockSemanticIndexMockRecorder struct {
+	mock *MockSemanticIndex
+}
+
+// NewMockSemanticIndex creates a new mock instance.
+func NewMockSemanticIndex(ctrl *gomock.Controller) *MockSemanticIndex {
+	mock := &MockSemanticIndex{ctrl: ctrl}
+	mock.recorder = &MockSemanticIndexMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSemanticIndex) EXPECT() *MockSemanticIndexMockRecorder {
+	return m.recorder
+}
+
+// SearchSemantic mocks base method.
+func (m *MockSemanticIndex) SearchSemantic(ctx context.Context, query, kind string, limit int) ([]SemanticHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSemantic", ctx, query, kind, limit)
+	ret0, _ := ret[0].([]SemanticHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSemantic indicates an expected call of SearchSemantic.
+func (mr *MockSemanticIndexMockRecorder) SearchSemantic(ctx, query, kind, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSemantic", reflect.TypeOf((*MockSemanticIndex)(nil).SearchSemantic), ctx, query, kind, limit)
+}
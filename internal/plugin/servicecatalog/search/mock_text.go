@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: text.go
+//
+// Generated by this command:
+//
+//	mockgen -source=text.go -destination=mock_text.go -package=search TextIndex
+//
+
+// Package search is a generated GoMock package.
+package search
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTextIndex is a mock of TextIndex interface.
+type MockTextIndex struct {
+	ctrl     *gomock.Controller
+	recorder *MockTextIndexMockRecorder
+	isgomock struct{}
+}
+
+// MockTextIndexMockRecorder is the mock recorder for MockTextIndex.
+type MockTextIndexMockRecorder struct {
+	mock *MockTextIndex
+}
+
+// NewMockTextIndex creates a new mock instance.
+func NewMockTextIndex(ctrl *gomock.Controller) *MockTextIndex {
+	mock := &MockTextIndex{ctrl: ctrl}
+	mock.recorder = &MockTextIndexMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTextIndex) EXPECT() *MockTextIndexMockRecorder {
+	return m.recorder
+}
+
+// SearchText mocks base method.
+func (m *MockTextIndex) SearchText(ctx context.Context, query, kind string, limit int) ([]TextHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchText", ctx, query, kind, limit)
+	ret0, _ := ret[0].([]TextHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchText indicates an expected call of SearchText.
+func (mr *MockTextIndexMockRecorder) SearchText(ctx, query, kind, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchText", reflect.TypeOf((*MockTextIndex)(nil).SearchText), ctx, query, kind, limit)
+}
@@ -0,0 +1,323 @@
+package search
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+)
+
+const (
+	// semanticModelVersion changes when training changes, so stored models of older versions are not used
+	semanticModelVersion = 1
+	// semanticDimensions is the number of concepts the texts are reduced to
+	semanticDimensions = 100
+	// minimumSimilarity leaves out entries that are hardly related to the query
+	minimumSimilarity = 0.1
+)
+
+// SemanticIndex defines the interface for ranking catalog entries on meaning rather than on the words they contain.
+//
+//go:generate go tool mockgen -source=semantic.go -destination=mock_semantic.go -package=search SemanticIndex
+type SemanticIndex interface {
+	SearchSemantic(ctx context.Context, query string, kind string, limit int) ([]SemanticHit, error)
+}
+
+// SemanticHit is a catalog entry related to a query, most similar first.
+type SemanticHit struct {
+	Kind        string  `json:"kind"`
+	ID          string  `json:"id"`
+	Similarity  float64 `json:"similarity"` // cosine similarity between 0 and 1
+	Description string  `json:"description,omitempty"`
+}
+
+type semanticIndex struct {
+	documents *Documents
+	store     repo.SemanticModelStorer // nil keeps the model in memory only
+	mutex     sync.Mutex
+	built     *semanticModel
+}
+
+// NewSemanticIndex creates an index that ranks modules and interfaces on their similarity to a query with latent
+// semantic analysis: TF-IDF vectors of their identifiers, descriptions, specifications and OpenAPI summaries are
+// reduced to a limited number of concepts, so texts using related words end up close together.
+// The model is trained in-process on first use. With a store it is kept between runs, so it is only retrained when
+// the texts change; without one (nil) it lives in memory only.
+func NewSemanticIndex(documents *Documents, store repo.SemanticModelStorer) SemanticIndex {
+	return &semanticIndex{
+		documents: documents,
+		store:     store,
+	}
+}
+
+// SearchSemantic returns the entries of the given kind (all kinds when empty) that are most similar to the query.
+func (idx *semanticIndex) SearchSemantic(ctx context.Context, query string, kind string, limit int) ([]SemanticHit, error) {
+	model, err := idx.model(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return model.search(query, kind, limit), nil
+}
+
+func (idx *semanticIndex) model(ctx context.Context) (*semanticModel, error) {
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	if idx.built != nil {
+		return idx.built, nil
+	}
+
+	documents, err := idx.documents.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := semanticEntriesOf(documents)
+	fingerprint := semanticFingerprint(entries)
+
+	stored, exists := idx.storedModel(ctx, fingerprint)
+	if !exists {
+		stored = trainSemanticModel(entries, semanticDimensions)
+		stored.Fingerprint = fingerprint
+		if idx.store != nil {
+			err = idx.store.PutSemanticModel(ctx, stored)
+			if err != nil {
+				// Still usable, only the next start has to train again
+				log.Warn().Err(err).Msg("Error storing semantic model")
+			}
+		}
+	}
+
+	idx.built = newSemanticModel(stored, entries)
+	return idx.built, nil
+}
+
+func (idx *semanticIndex) storedModel(ctx context.Context, fingerprint string) (repo.SemanticModel, bool) {
+	if idx.store == nil {
+		return repo.SemanticModel{}, false
+	}
+	stored, exists, err := idx.store.GetSemanticModel(ctx, fingerprint)
+	if err != nil {
+		log.Warn().Err(err).Msg("Error reading stored semantic model: retraining")
+		return repo.SemanticModel{}, false
+	}
+	return stored, exists
+}
+
+// semanticEntry is a module or interface with all its texts
+type semanticEntry struct {
+	kind        string
+	id          string
+	description string
+	text        string
+}
+
+func semanticEntriesOf(documents []textDocument) []semanticEntry {
+	entries := []semanticEntry{}
+	positions := map[string]int{} // keyed on kind and ID
+	for _, document := range documents {
+		key := document.kind + ":" + document.id
+		position, found := positions[key]
+		if !found {
+			position = len(entries)
+			positions[key] = position
+			entries = append(entries, semanticEntry{
+				kind: document.kind,
+				id:   document.id,
+				text: identifierWords(document.id),
+			})
+		}
+		if document.field == FieldDescription {
+			entries[position].description = document.text
+		}
+		entries[position].text += "\n" + document.text
+	}
+	return entries
+}
+
+var identifierBoundary = regexp.MustCompile(`(\p{Ll}|\p{N})(\p{Lu})`)
+
+// identifierWords splits a camel-cased identifier like PartnerTermsResourceV1 into its words
+func identifierWords(id string) string {
+	return identifierBoundary.ReplaceAllString(id, "$1 $2")
+}
+
+func semanticFingerprint(entries []semanticEntry) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "version=%d dimensions=%d\n", semanticModelVersion, semanticDimensions)
+	for _, entry := range entries {
+		fmt.Fprintf(hash, "%s\x00%s\x00%s\x00", entry.kind, entry.id, entry.text)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// trainSemanticModel reduces the TF-IDF vectors of the entries to the given number of dimensions
+func trainSemanticModel(entries []semanticEntry, dimensions int) repo.SemanticModel {
+	frequencies := make([]map[string]int, len(entries))
+	documentFrequencies := map[string]int{}
+	for i, entry := range entries {
+		frequencies[i] = map[string]int{}
+		for _, term := range tokenize(entry.text) {
+			if frequencies[i][term] == 0 {
+				documentFrequencies[term]++
+			}
+			frequencies[i][term]++
+		}
+	}
+
+	terms := make([]string, 0, len(documentFrequencies))
+	for term := range documentFrequencies {
+		terms = append(terms, term)
+	}
+	slices.Sort(terms)
+	termIndex := map[string]int{}
+	idf := make([]float64, len(terms))
+	for i, term := range terms {
+		termIndex[term] = i
+		idf[i] = math.Log(float64(1+len(entries))/float64(1+documentFrequencies[term])) + 1
+	}
+
+	columns := make([]sparseVector, len(entries))
+	for i := range entries {
+		weights := map[int]float64{}
+		for term, frequency := range frequencies[i] {
+			weights[termIndex[term]] = termWeight(frequency, idf[termIndex[term]])
+		}
+		columns[i] = normalizedSparseVector(weights)
+	}
+
+	termVectors := leftSingularVectors(columns, len(terms), dimensions)
+
+	model := repo.SemanticModel{
+		Terms:     make([]repo.SemanticTerm, len(terms)),
+		Documents: make([]repo.SemanticDocument, len(entries)),
+	}
+	if len(terms) > 0 {
+		model.Dimensions = len(termVectors[0])
+	}
+	for i, term := range terms {
+		model.Terms[i] = repo.SemanticTerm{Term: term, IDF: idf[i], Vector: toFloat32(termVectors[i])}
+	}
+	for i, entry := range entries {
+		vector := make([]float64, model.Dimensions)
+		for j, term := range columns[i].indices {
+			for d := range vector {
+				vector[d] += columns[i].values[j] * termVectors[term][d]
+			}
+		}
+		model.Documents[i] = repo.SemanticDocument{Kind: entry.kind, ID: entry.id, Vector: toFloat32(vector)}
+	}
+	return model
+}
+
+// termWeight dampens repeated terms: the tenth occurrence adds less than the second
+func termWeight(frequency int, idf float64) float64 {
+	return (1 + math.Log(float64(frequency))) * idf
+}
+
+func normalizedSparseVector(weights map[int]float64) sparseVector {
+	vector := sparseVector{}
+	norm := 0.0
+	for index, weight := range weights {
+		vector.indices = append(vector.indices, index)
+		norm += weight * weight
+	}
+	slices.Sort(vector.indices)
+	norm = math.Sqrt(norm)
+	for _, index := range vector.indices {
+		vector.values = append(vector.values, weights[index]/norm)
+	}
+	return vector
+}
+
+func toFloat32(vector []float64) []float32 {
+	result := make([]float32, len(vector))
+	for i, value := range vector {
+		result[i] = float32(value)
+	}
+	return result
+}
+
+type semanticModel struct {
+	dimensions   int
+	terms        map[string]repo.SemanticTerm
+	documents    []repo.SemanticDocument
+	descriptions map[string]string // keyed on kind and ID
+}
+
+func newSemanticModel(stored repo.SemanticModel, entries []semanticEntry) *semanticModel {
+	model := &semanticModel{
+		dimensions:   stored.Dimensions,
+		terms:        map[string]repo.SemanticTerm{},
+		documents:    stored.Documents,
+		descriptions: map[string]string{},
+	}
+	for _, term := range stored.Terms {
+		model.terms[term.Term] = term
+	}
+	for _, entry := range entries {
+		model.descriptions[entry.kind+":"+entry.id] = entry.description
+	}
+	return model
+}
+
+func (model *semanticModel) search(query string, kind string, limit int) []SemanticHit {
+	frequencies := map[string]int{}
+	for _, term := range tokenize(identifierWords(query)) {
+		frequencies[term]++
+	}
+	queryVector := make([]float64, model.dimensions)
+	for term, frequency := range frequencies {
+		known, found := model.terms[term]
+		if !found {
+			continue
+		}
+		weight := termWeight(frequency, known.IDF)
+		for d, value := range known.Vector {
+			queryVector[d] += weight * float64(value)
+		}
+	}
+
+	hits := []SemanticHit{}
+	for _, document := range model.documents {
+		if kind != "" && document.Kind != kind {
+			continue
+		}
+		similarity := cosineSimilarity(queryVector, document.Vector)
+		if similarity < minimumSimilarity {
+			continue
+		}
+		hits = append(hits, SemanticHit{
+			Kind:        document.Kind,
+			ID:          document.ID,
+			Similarity:  math.Round(similarity*1000) / 1000,
+			Description: strings.TrimSpace(model.descriptions[document.Kind+":"+document.ID]),
+		})
+	}
+	slices.SortStableFunc(hits, func(a, b SemanticHit) int {
+		return cmp.Or(cmp.Compare(b.Similarity, a.Similarity), cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.ID, b.ID))
+	})
+	return hits[:min(len(hits), limit)]
+}
+
+func cosineSimilarity(a []float64, b []float32) float64 {
+	dot, normA, normB := 0.0, 0.0, 0.0
+	for i := range min(len(a), len(b)) {
+		dot += a[i] * float64(b[i])
+		normA += a[i] * a[i]
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / math.Sqrt(normA*normB)
+}
@@ -0,0 +1,125 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
+)
+
+var semanticTestModules = []repo.Module{
+	{ModuleID: "issuer-claims", Description: "Handles chargebacks raised by issuers"},
+	{ModuleID: "payouts", Description: "Pays out balances to merchants"},
+	{ModuleID: "weather", Description: "Forecasts sunny weather for merchants"},
+}
+
+var semanticTestInterfaces = []repo.Interface{
+	{InterfaceID: "DisputeServiceV1", Description: "Defend chargebacks and other disputes raised by issuers"},
+}
+
+func TestTrainSemanticModel_RelatedWords(t *testing.T) {
+	documents := []textDocument{}
+	for _, module := range semanticTestModules {
+		documents = append(documents, textDocument{kind: KindModule, id: module.ModuleID, field: FieldDescription, text: module.Description})
+	}
+	for _, iface := range semanticTestInterfaces {
+		documents = append(documents, textDocument{kind: KindInterface, id: iface.InterfaceID, field: FieldDescription, text: iface.Description})
+	}
+	entries := semanticEntriesOf(documents)
+
+	model := newSemanticModel(trainSemanticModel(entries, 2), entries)
+	hits := model.search("dispute", "", 10)
+
+	// issuer-claims never mentions disputes, but shares chargebacks and issuers with the interface that does
+	ids := []string{}
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+	assert.Equal(t, []string{"DisputeServiceV1", "issuer-claims"}, ids)
+	assert.Equal(t, "Handles chargebacks raised by issuers", hits[1].Description)
+	assert.GreaterOrEqual(t, hits[1].Similarity, minimumSimilarity)
+}
+
+func TestSemanticIndex_TrainsAndStoresModel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	cataloger := repo.NewMockCataloger(ctrl)
+	cataloger.EXPECT().ListModules(gomock.Any(), "").Return(semanticTestModules, nil)
+	cataloger.EXPECT().ListInterfaces(gomock.Any(), "").Return(semanticTestInterfaces, nil)
+	store := repo.NewMockSemanticModelStorer(ctrl)
+	store.EXPECT().GetSemanticModel(gomock.Any(), gomock.Any()).Return(repo.SemanticModel{}, false, nil)
+	stored := repo.SemanticModel{}
+	store.EXPECT().PutSemanticModel(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, model repo.SemanticModel) error {
+		stored = model
+		return nil
+	})
+
+	idx := NewSemanticIndex(NewDocuments(cataloger, spec.NewMockLoader(ctrl)), store)
+
+	hits, err := idx.SearchSemantic(context.Background(), "sunny weather", KindModule, 10)
+	assert.NoError(t, err)
+	assert.Len(t, hits, 1)
+	assert.Equal(t, SemanticHit{Kind: KindModule, ID: "weather", Similarity: hits[0].Similarity, Description: "Forecasts sunny weather for merchants"}, hits[0])
+
+	assert.Len(t, stored.Fingerprint, 64)
+	assert.Len(t, stored.Documents, 4)
+	assert.Equal(t, stored.Dimensions, len(stored.Documents[0].Vector))
+
+	// trained only once
+	hits, err = idx.SearchSemantic(context.Background(), "chargebacks", KindInterface, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, "DisputeServiceV1", hits[0].ID)
+}
+
+func TestSemanticIndex_UsesStoredModel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	cataloger := repo.NewMockCataloger(ctrl)
+	cataloger.EXPECT().ListModules(gomock.Any(), "").Return(semanticTestModules, nil)
+	cataloger.EXPECT().ListInterfaces(gomock.Any(), "").Return(semanticTestInterfaces, nil)
+	store := repo.NewMockSemanticModelStorer(ctrl)
+	store.EXPECT().GetSemanticModel(gomock.Any(), gomock.Any()).Return(repo.SemanticModel{
+		Dimensions: 2,
+		Terms:      []repo.SemanticTerm{{Term: "payout", IDF: 1, Vector: []float32{1, 0}}},
+		Documents: []repo.SemanticDocument{
+			{Kind: KindModule, ID: "payouts", Vector: []float32{0.9, 0.1}},
+			{Kind: KindModule, ID: "weather", Vector: []float32{0, 1}},
+		},
+	}, true, nil)
+
+	idx := NewSemanticIndex(NewDocuments(cataloger, spec.NewMockLoader(ctrl)), store)
+
+	hits, err := idx.SearchSemantic(context.Background(), "payouts", "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []SemanticHit{{Kind: KindModule, ID: "payouts", Similarity: 0.994, Description: "Pays out balances to merchants"}}, hits)
+}
+
+func TestSemanticIndex_WithoutStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	cataloger := repo.NewMockCataloger(ctrl)
+	cataloger.EXPECT().ListModules(gomock.Any(), "").Return(semanticTestModules, nil)
+	cataloger.EXPECT().ListInterfaces(gomock.Any(), "").Return(semanticTestInterfaces, nil)
+
+	idx := NewSemanticIndex(NewDocuments(cataloger, spec.NewMockLoader(ctrl)), nil)
+
+	hits, err := idx.SearchSemantic(context.Background(), "sunny weather", KindModule, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, "weather", hits[0].ID)
+}
+
+func TestSemanticIndex_UnknownWords(t *testing.T) {
+	entries := []semanticEntry{{kind: KindModule, id: "weather", text: "Forecasts sunny weather"}}
+	model := newSemanticModel(trainSemanticModel(entries, 2), entries)
+
+	assert.Empty(t, model.search("kubernetes", "", 10))
+}
+
+func TestIdentifierWords(t *testing.T) {
+	assert.Equal(t, "Partner Terms Resource V1", identifierWords("PartnerTermsResourceV1"))
+	assert.Equal(t, "communication/services/partner", identifierWords("communication/services/partner"))
+}
@@ -0,0 +1,290 @@
+package search
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Kinds of catalog entries that can be searched
+const (
+	KindModule    = "module"
+	KindInterface = "interface"
+)
+
+// Fields of catalog entries that the text index covers
+const (
+	FieldDescription      = "description"
+	FieldSpecification    = "specification"
+	FieldOperationSummary = "operation_summary"
+)
+
+// TextIndex defines the interface for a full-text index over the descriptions and specifications in the catalog.
+//
+//go:generate go tool mockgen -source=text.go -destination=mock_text.go -package=search TextIndex
+type TextIndex interface {
+	SearchText(ctx context.Context, query string, kind string, limit int) ([]TextHit, error)
+}
+
+// TextHit is a catalog entry that matches a full-text query, best match first.
+type TextHit struct {
+	Kind    string      `json:"kind"`
+	ID      string      `json:"id"`
+	Score   float64     `json:"score"`
+	Matches []TextMatch `json:"matches"`
+}
+
+// TextMatch is a field of a catalog entry that matches a full-text query.
+type TextMatch struct {
+	Field     string `json:"field"`
+	Operation string `json:"operation,omitempty"` // operationId or "METHOD /path" of an operation summary
+	Snippet   string `json:"snippet"`
+}
+
+type textIndex struct {
+	documents *Documents
+	mutex     sync.Mutex
+	built     *invertedIndex
+}
+
+// NewTextIndex creates a full-text index over the module descriptions and specifications, the interface descriptions
+// and the summaries of the OpenAPI operations of the documents.
+// The index is built on first use, because reading all OpenAPI specifications takes a while.
+func NewTextIndex(documents *Documents) TextIndex {
+	return &textIndex{
+		documents: documents,
+	}
+}
+
+// SearchText returns the entries of the given kind (all kinds when empty) that contain one or more of the words of
+// the query, ranked with BM25.
+func (idx *textIndex) SearchText(ctx context.Context, query string, kind string, limit int) ([]TextHit, error) {
+	index, err := idx.index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return index.search(query, kind, limit), nil
+}
+
+func (idx *textIndex) index(ctx context.Context) (*invertedIndex, error) {
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	if idx.built == nil {
+		documents, err := idx.documents.list(ctx)
+		if err != nil {
+			return nil, err
+		}
+		idx.built = newInvertedIndex(documents)
+	}
+	return idx.built, nil
+}
+
+type textDocument struct {
+	kind      string
+	id        string
+	field     string
+	operation string
+	text      string
+	length    int // number of terms
+}
+
+type posting struct {
+	document  int
+	frequency int
+}
+
+type invertedIndex struct {
+	documents     []textDocument
+	postings      map[string][]posting // keyed on term
+	averageLength float64
+}
+
+func newInvertedIndex(documents []textDocument) *invertedIndex {
+	index := &invertedIndex{
+		documents: []textDocument{},
+		postings:  map[string][]posting{},
+	}
+	totalLength := 0
+	for _, document := range documents {
+		terms := tokenize(document.text)
+		if len(terms) == 0 {
+			continue
+		}
+		document.length = len(terms)
+		totalLength += len(terms)
+
+		frequencies := map[string]int{}
+		for _, term := range terms {
+			frequencies[term]++
+		}
+		for term, frequency := range frequencies {
+			index.postings[term] = append(index.postings[term], posting{document: len(index.documents), frequency: frequency})
+		}
+		index.documents = append(index.documents, document)
+	}
+	if len(index.documents) > 0 {
+		index.averageLength = float64(totalLength) / float64(len(index.documents))
+	}
+	return index
+}
+
+// BM25 tuning: saturation of term frequency and normalization on document length
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+func (index *invertedIndex) search(query string, kind string, limit int) []TextHit {
+	queryTerms := map[string]bool{}
+	for _, term := range tokenize(query) {
+		queryTerms[term] = true
+	}
+
+	scores := map[int]float64{} // keyed on document
+	for term := range queryTerms {
+		postings := index.postings[term]
+		if len(postings) == 0 {
+			continue
+		}
+		documentCount := float64(len(index.documents))
+		idf := math.Log(1 + (documentCount-float64(len(postings))+0.5)/(float64(len(postings))+0.5))
+		for _, p := range postings {
+			document := index.documents[p.document]
+			if kind != "" && document.kind != kind {
+				continue
+			}
+			frequency := float64(p.frequency)
+			lengthRatio := float64(document.length) / index.averageLength
+			scores[p.document] += idf * frequency * (bm25K1 + 1) / (frequency + bm25K1*(1-bm25B+bm25B*lengthRatio))
+		}
+	}
+
+	// Combine the matching fields per catalog entry, best matching field first
+	matching := make([]int, 0, len(scores))
+	for document := range scores {
+		matching = append(matching, document)
+	}
+	slices.SortFunc(matching, func(a, b int) int {
+		return cmp.Or(cmp.Compare(scores[b], scores[a]), cmp.Compare(a, b))
+	})
+	hits := []TextHit{}
+	hitIndex := map[string]int{} // keyed on kind and ID
+	for _, d := range matching {
+		document := index.documents[d]
+		key := document.kind + ":" + document.id
+		position, found := hitIndex[key]
+		if !found {
+			position = len(hits)
+			hitIndex[key] = position
+			hits = append(hits, TextHit{Kind: document.kind, ID: document.id, Matches: []TextMatch{}})
+		}
+		hits[position].Score += scores[d]
+		hits[position].Matches = append(hits[position].Matches, TextMatch{
+			Field:     document.field,
+			Operation: document.operation,
+			Snippet:   snippet(document.text, queryTerms),
+		})
+	}
+
+	for i := range hits {
+		hits[i].Score = math.Round(hits[i].Score*1000) / 1000
+	}
+	slices.SortStableFunc(hits, func(a, b TextHit) int {
+		return cmp.Or(cmp.Compare(b.Score, a.Score), cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.ID, b.ID))
+	})
+	return hits[:min(len(hits), limit)]
+}
+
+// Number of words shown around the first matching word of a snippet
+const (
+	snippetWordsBefore = 8
+	snippetWordsAfter  = 16
+)
+
+// snippet returns the part of the text around the first word that matches the query, with matching words in bold.
+func snippet(text string, queryTerms map[string]bool) string {
+	text = strings.Join(strings.Fields(text), " ")
+	words := wordPattern.FindAllStringIndex(text, -1)
+	matches := make([]bool, len(words))
+	first := -1
+	for i, word := range words {
+		term, ok := normalizeTerm(text[word[0]:word[1]])
+		matches[i] = ok && queryTerms[term]
+		if matches[i] && first < 0 {
+			first = i
+		}
+	}
+	if first < 0 {
+		return ""
+	}
+
+	from := max(0, first-snippetWordsBefore)
+	to := min(len(words)-1, first+snippetWordsAfter)
+	start, end := words[from][0], words[to][1]
+
+	builder := strings.Builder{}
+	if start > 0 {
+		builder.WriteString("…")
+	}
+	position := start
+	for i := from; i <= to; i++ {
+		if !matches[i] {
+			continue
+		}
+		builder.WriteString(text[position:words[i][0]])
+		builder.WriteString("**" + text[words[i][0]:words[i][1]] + "**")
+		position = words[i][1]
+	}
+	builder.WriteString(text[position:end])
+	if end < len(text) {
+		builder.WriteString("…")
+	}
+	return builder.String()
+}
+
+var wordPattern = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// tokenize splits a text into normalized terms, leaving out stop words
+func tokenize(text string) []string {
+	terms := []string{}
+	for _, word := range wordPattern.FindAllString(text, -1) {
+		if term, ok := normalizeTerm(word); ok {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+func normalizeTerm(word string) (string, bool) {
+	word = strings.ToLower(word)
+	if len(word) < 2 || stopWords[word] {
+		return "", false
+	}
+	return stem(word), true
+}
+
+// stem strips the most common English plural endings, so "chargebacks" matches "chargeback"
+func stem(word string) string {
+	switch {
+	case len(word) > 4 && strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case strings.HasSuffix(word, "sses"), strings.HasSuffix(word, "ches"), strings.HasSuffix(word, "shes"), strings.HasSuffix(word, "xes"):
+		return strings.TrimSuffix(word, "es")
+	case len(word) > 3 && strings.HasSuffix(word, "s") &&
+		!strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us") && !strings.HasSuffix(word, "is"):
+		return strings.TrimSuffix(word, "s")
+	}
+	return word
+}
+
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true, "by": true, "can": true,
+	"do": true, "does": true, "for": true, "from": true, "has": true, "have": true, "how": true, "in": true,
+	"is": true, "it": true, "its": true, "of": true, "on": true, "or": true, "that": true, "the": true,
+	"this": true, "to": true, "was": true, "what": true, "which": true, "who": true, "will": true, "with": true,
+}
@@ -0,0 +1,115 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
+)
+
+const disputeSpec = `{
+  "openapi": "3.0.0",
+  "info": {"title": "Disputes", "version": "1"},
+  "paths": {
+    "/disputes/{id}/defend": {
+      "post": {"operationId": "defendDispute", "summary": "Defend a chargeback with evidence"}
+    },
+    "/disputes": {
+      "get": {"summary": "List open disputes"}
+    }
+  }
+}`
+
+func newTestTextIndex(t *testing.T) TextIndex {
+	ctrl := gomock.NewController(t)
+
+	cataloger := repo.NewMockCataloger(ctrl)
+	cataloger.EXPECT().ListModules(gomock.Any(), "").Return([]repo.Module{
+		{ModuleID: "payments", Description: "Authorizes and captures card payments"},
+		{ModuleID: "disputes", Description: "Handles chargebacks and notifications of disputes raised by issuers", Spec: "disputes/meta.json"},
+		{ModuleID: "reporting", Description: "Generates settlement reports"},
+	}, nil)
+	cataloger.EXPECT().ListInterfaces(gomock.Any(), "").Return([]repo.Interface{
+		{InterfaceID: "DisputeServiceV1", Description: "Manage disputes", OpenAPISpecs: stringPointer("disputes/openapi.json")},
+		{InterfaceID: "PaymentServiceV1", Description: "Make a payment", OpenAPISpecs: stringPointer("payments/openapi.json")},
+	}, nil)
+
+	loader := spec.NewMockLoader(ctrl)
+	loader.EXPECT().Load(gomock.Any(), "disputes/openapi.json").Return([]byte(disputeSpec), true, nil)
+	loader.EXPECT().Load(gomock.Any(), "payments/openapi.json").Return(nil, false, nil)
+
+	return NewTextIndex(NewDocuments(cataloger, loader))
+}
+
+func TestTextIndex_SearchText(t *testing.T) {
+	idx := newTestTextIndex(t)
+
+	hits, err := idx.SearchText(context.Background(), "which module handles chargebacks", "", 10)
+	assert.NoError(t, err)
+
+	assert.Len(t, hits, 2)
+	assert.Equal(t, KindModule, hits[0].Kind)
+	assert.Equal(t, "disputes", hits[0].ID)
+	assert.Equal(t, []TextMatch{{
+		Field:   FieldDescription,
+		Snippet: "**Handles** **chargebacks** and notifications of disputes raised by issuers",
+	}}, hits[0].Matches)
+
+	assert.Equal(t, KindInterface, hits[1].Kind)
+	assert.Equal(t, "DisputeServiceV1", hits[1].ID)
+	assert.Equal(t, []TextMatch{{
+		Field:     FieldOperationSummary,
+		Operation: "defendDispute",
+		Snippet:   "Defend a **chargeback** with evidence",
+	}}, hits[1].Matches)
+	assert.Greater(t, hits[0].Score, 0.0)
+}
+
+func TestTextIndex_SearchTextOfKind(t *testing.T) {
+	idx := newTestTextIndex(t)
+
+	hits, err := idx.SearchText(context.Background(), "disputes", KindInterface, 10)
+	assert.NoError(t, err)
+
+	assert.Len(t, hits, 1)
+	assert.Equal(t, "DisputeServiceV1", hits[0].ID)
+	assert.Len(t, hits[0].Matches, 2)
+	assert.Equal(t, "Manage **disputes**", hits[0].Matches[0].Snippet)
+	assert.Equal(t, "GET /disputes", hits[0].Matches[1].Operation)
+}
+
+func TestTextIndex_SearchTextNoMatch(t *testing.T) {
+	idx := newTestTextIndex(t)
+
+	hits, err := idx.SearchText(context.Background(), "the of", "", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, hits)
+
+	hits, err = idx.SearchText(context.Background(), "kubernetes", "", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, hits)
+}
+
+func TestSnippet(t *testing.T) {
+	text := "one two three four five six seven eight nine ten eleven twelve\n thirteen  fourteen"
+	assert.Equal(t, "…three four five six seven eight nine ten **eleven** twelve thirteen fourteen",
+		snippet(text, map[string]bool{"eleven": true}))
+	assert.Equal(t, "", snippet(text, map[string]bool{"twenty": true}))
+}
+
+func TestStem(t *testing.T) {
+	assert.Equal(t, "chargeback", stem("chargebacks"))
+	assert.Equal(t, "policy", stem("policies"))
+	assert.Equal(t, "process", stem("processes"))
+	assert.Equal(t, "match", stem("matches"))
+	assert.Equal(t, "status", stem("status"))
+	assert.Equal(t, "address", stem("address"))
+}
+
+func stringPointer(val string) *string {
+	return &val
+}
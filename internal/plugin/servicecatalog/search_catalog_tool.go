@@ -0,0 +1,71 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+// TextSearchResult holds the catalog entries that match a full-text query, best match first.
+type TextSearchResult struct {
+	Hits []search.TextHit `json:"hits"`
+}
+
+// NewSearchCatalogTool returns the MCP tool definition and its handler for full-text search over descriptions and specifications.
+func (h *mcpHandler) searchCatalogTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"search_catalog",
+			mcp.WithDescription("Full-text search over the descriptions and specifications of modules, the descriptions of interfaces and the summaries of their OpenAPI operations. "+
+				"Returns the matching modules and interfaces ranked on relevance, with snippets of the matching text. "+
+				"Use this when the user describes functionality (e.g. \"which module handles chargebacks\") instead of naming an identifier."),
+			mcp.WithString("query", mcp.Required(), mcp.Description("The words to search for. Entries matching more (and rarer) words rank higher.")),
+			mcp.WithString("kind", mcp.Enum(search.KindModule, search.KindInterface), mcp.Description("Only return entries of this kind. Omit for both.")),
+			mcp.WithNumber("limit_to", mcp.Description("Maximum number of results to return (default 10).")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[TextSearchResult](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			query, err := request.RequireString("query")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing query",
+						"query",
+						"Use one or more words describing the functionality")), nil
+			}
+			kind := request.GetString("kind", "")
+			if kind != "" && kind != search.KindModule && kind != search.KindInterface {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid kind %s", kind),
+						"kind",
+						fmt.Sprintf("Use %s or %s, or omit it", search.KindModule, search.KindInterface))), nil
+			}
+			limit := request.GetInt("limit_to", 10)
+			if limit < 1 {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid limit_to %d", limit),
+						"limit_to",
+						"Use a positive number")), nil
+			}
+
+			// call business logic
+			hits, err := h.text.SearchText(ctx, query, kind, limit)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error searching catalog for %s: %s", query, err))), nil
+			}
+
+			return mcp.NewToolResultJSON[TextSearchResult](TextSearchResult{
+				Hits: hits,
+			})
+		},
+	}
+}
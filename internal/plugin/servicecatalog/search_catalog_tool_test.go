@@ -0,0 +1,95 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestSearchCatalogTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	text := search.NewMockTextIndex(ctrl)
+	text.EXPECT().SearchText(gomock.Any(), "chargebacks", search.KindModule, 5).Return([]search.TextHit{{
+		Kind:    search.KindModule,
+		ID:      "disputes",
+		Score:   1.5,
+		Matches: []search.TextMatch{{Field: search.FieldDescription, Snippet: "Handles **chargebacks**"}},
+	}}, nil)
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil, WithTextIndex(text)).searchCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("search_catalog", map[string]interface{}{
+		"query":    "chargebacks",
+		"kind":     "module",
+		"limit_to": 5,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Equal(t, `{"hits":[{"kind":"module","id":"disputes","score":1.5,"matches":[{"field":"description","snippet":"Handles **chargebacks**"}]}]}`, textResult.Text)
+}
+
+func TestSearchCatalogTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	text := search.NewMockTextIndex(ctrl)
+	text.EXPECT().SearchText(gomock.Any(), "chargebacks", "", 10).Return(nil, errors.New("db error"))
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil, WithTextIndex(text)).searchCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("search_catalog", map[string]interface{}{
+		"query": "chargebacks",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "error searching catalog for chargebacks: db error")
+}
+
+func TestSearchCatalogTool_MissingQuery(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil, WithTextIndex(search.NewMockTextIndex(ctrl))).searchCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("search_catalog", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Missing query")
+}
+
+func TestSearchCatalogTool_InvalidKind(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil, WithTextIndex(search.NewMockTextIndex(ctrl))).searchCatalogTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("search_catalog", map[string]interface{}{
+		"query": "chargebacks",
+		"kind":  "team",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Invalid kind team")
+}
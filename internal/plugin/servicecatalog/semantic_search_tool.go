@@ -0,0 +1,71 @@
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/core/resp"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+// SemanticSearchResult holds the catalog entries most similar in meaning to a query, most similar first.
+type SemanticSearchResult struct {
+	Hits []search.SemanticHit `json:"hits"`
+}
+
+// NewSemanticSearchTool returns the MCP tool definition and its handler for searching modules and interfaces on meaning.
+func (h *mcpHandler) semanticSearchTool() server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool(
+			"semantic_search",
+			mcp.WithDescription("Ranks modules and interfaces on how close their identifiers, descriptions and specifications are in meaning to a question in business language, "+
+				"also when they do not contain the exact words. Runs fully offline on a latent semantic model of the catalog. "+
+				"Prefer search_catalog when the exact words are known."),
+			mcp.WithString("query", mcp.Required(), mcp.Description("The question or description of the functionality, e.g. \"who pays out merchants\".")),
+			mcp.WithString("kind", mcp.Enum(search.KindModule, search.KindInterface), mcp.Description("Only return entries of this kind. Omit for both.")),
+			mcp.WithNumber("limit_to", mcp.Description("Maximum number of results to return (default 10).")),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithOutputSchema[SemanticSearchResult](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
+			query, err := request.RequireString("query")
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing query",
+						"query",
+						"Describe the functionality you are looking for")), nil
+			}
+			kind := request.GetString("kind", "")
+			if kind != "" && kind != search.KindModule && kind != search.KindInterface {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid kind %s", kind),
+						"kind",
+						fmt.Sprintf("Use %s or %s, or omit it", search.KindModule, search.KindInterface))), nil
+			}
+			limit := request.GetInt("limit_to", 10)
+			if limit < 1 {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid limit_to %d", limit),
+						"limit_to",
+						"Use a positive number")), nil
+			}
+
+			// call business logic
+			hits, err := h.semantic.SearchSemantic(ctx, query, kind, limit)
+			if err != nil {
+				return mcp.NewToolResultError(
+					resp.InternalError(ctx,
+						fmt.Sprintf("error searching catalog on meaning of %s: %s", query, err))), nil
+			}
+
+			return mcp.NewToolResultJSON[SemanticSearchResult](SemanticSearchResult{
+				Hits: hits,
+			})
+		},
+	}
+}
@@ -0,0 +1,95 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+)
+
+func TestSemanticSearchTool_Success(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	semantic := search.NewMockSemanticIndex(ctrl)
+	semantic.EXPECT().SearchSemantic(gomock.Any(), "who handles disputes", search.KindModule, 5).Return([]search.SemanticHit{{
+		Kind:        search.KindModule,
+		ID:          "issuer-claims",
+		Similarity:  0.75,
+		Description: "Handles chargebacks",
+	}}, nil)
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil, WithSemanticIndex(semantic)).semanticSearchTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("semantic_search", map[string]interface{}{
+		"query":    "who handles disputes",
+		"kind":     "module",
+		"limit_to": 5,
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Equal(t, `{"hits":[{"kind":"module","id":"issuer-claims","similarity":0.75,"description":"Handles chargebacks"}]}`, textResult.Text)
+}
+
+func TestSemanticSearchTool_Error(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	semantic := search.NewMockSemanticIndex(ctrl)
+	semantic.EXPECT().SearchSemantic(gomock.Any(), "chargebacks", "", 10).Return(nil, errors.New("db error"))
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil, WithSemanticIndex(semantic)).semanticSearchTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("semantic_search", map[string]interface{}{
+		"query": "chargebacks",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "error searching catalog on meaning of chargebacks: db error")
+}
+
+func TestSemanticSearchTool_MissingQuery(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil, WithSemanticIndex(search.NewMockSemanticIndex(ctrl))).semanticSearchTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("semantic_search", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Missing query")
+}
+
+func TestSemanticSearchTool_InvalidKind(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tool := NewMCPHandler(repo.NewMockCataloger(ctrl), nil, WithSemanticIndex(search.NewMockSemanticIndex(ctrl))).semanticSearchTool()
+
+	// When
+	result, err := tool.Handler(context.Background(), createRequest("semantic_search", map[string]interface{}{
+		"query": "chargebacks",
+		"kind":  "team",
+	}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Invalid kind team")
+}
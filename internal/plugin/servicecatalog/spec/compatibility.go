@@ -0,0 +1,353 @@
+package spec
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ChangeKind classifies a difference between two versions of an OpenAPI specification.
+type ChangeKind string
+
+const (
+	// ChangeRemovedOperation means an operation no longer exists
+	ChangeRemovedOperation ChangeKind = "removed-operation"
+	// ChangeAddedOperation means an operation was introduced
+	ChangeAddedOperation ChangeKind = "added-operation"
+	// ChangeDeprecatedOperation means an operation became deprecated
+	ChangeDeprecatedOperation ChangeKind = "deprecated-operation"
+	// ChangeNewRequiredParameter means a parameter was introduced as required or became required
+	ChangeNewRequiredParameter ChangeKind = "new-required-parameter"
+	// ChangeAddedParameter means an optional parameter was introduced
+	ChangeAddedParameter ChangeKind = "added-parameter"
+	// ChangeRemovedParameter means a parameter is no longer read
+	ChangeRemovedParameter ChangeKind = "removed-parameter"
+	// ChangeAddedRequestBody means a request body was introduced
+	ChangeAddedRequestBody ChangeKind = "added-request-body"
+	// ChangeRemovedRequestBody means a request body is no longer read
+	ChangeRemovedRequestBody ChangeKind = "removed-request-body"
+	// ChangeAddedResponse means a response status was introduced
+	ChangeAddedResponse ChangeKind = "added-response"
+	// ChangeRemovedResponse means a response status is no longer returned
+	ChangeRemovedResponse ChangeKind = "removed-response"
+	// ChangeNewRequiredField means a request field or the request body was introduced as required or became required
+	ChangeNewRequiredField ChangeKind = "new-required-field"
+	// ChangeAddedField means an optional request field or a response field was introduced
+	ChangeAddedField ChangeKind = "added-field"
+	// ChangeRemovedField means a field no longer exists
+	ChangeRemovedField ChangeKind = "removed-field"
+	// ChangeChangedType means the type of a parameter or field changed
+	ChangeChangedType ChangeKind = "changed-type"
+	// ChangeNarrowedEnum means values were removed from an enumeration
+	ChangeNarrowedEnum ChangeKind = "narrowed-enum"
+	// ChangeWidenedEnum means values were added to an enumeration
+	ChangeWidenedEnum ChangeKind = "widened-enum"
+)
+
+// Change is a difference between two versions of an operation, classified as breaking or non-breaking for existing clients.
+type Change struct {
+	Operation   string     `json:"operation"`
+	Kind        ChangeKind `json:"kind"`
+	Location    string     `json:"location,omitempty"`
+	Breaking    bool       `json:"breaking"`
+	Description string     `json:"description"`
+}
+
+type direction int
+
+const (
+	request direction = iota
+	response
+)
+
+// CompareOpenAPI reports the differences between an old and a new version of an OpenAPI specification, breaking changes first.
+// Named schemas are compared field by field, also when nested. Inline schemas are only compared on type.
+// The fields below a parameter or field whose type changed are not compared: the type change already breaks its clients.
+func CompareOpenAPI(before, after OpenAPISpec) []Change {
+	c := comparison{before: before, after: after, changes: []Change{}, visiting: map[string]bool{}}
+
+	for _, operation := range before.Operations {
+		key := operation.Key()
+		newOperation, found := after.operations[key]
+		if !found {
+			c.add(key, ChangeRemovedOperation, "", true, "operation was removed")
+			continue
+		}
+		c.operation(key, before.operations[key], newOperation)
+	}
+	for _, operation := range after.Operations {
+		if _, found := before.operations[operation.Key()]; !found {
+			c.add(operation.Key(), ChangeAddedOperation, "", false, "operation was added")
+		}
+	}
+
+	sort.SliceStable(c.changes, func(i, j int) bool {
+		if c.changes[i].Breaking != c.changes[j].Breaking {
+			return c.changes[i].Breaking
+		}
+		if c.changes[i].Operation != c.changes[j].Operation {
+			return c.changes[i].Operation < c.changes[j].Operation
+		}
+		return c.changes[i].Location < c.changes[j].Location
+	})
+
+	return c.changes
+}
+
+type comparison struct {
+	before   OpenAPISpec
+	after    OpenAPISpec
+	changes  []Change
+	visiting map[string]bool // referenced schemas on the current path
+}
+
+func (c *comparison) add(operation string, kind ChangeKind, location string, breaking bool, description string) {
+	c.changes = append(c.changes, Change{
+		Operation:   operation,
+		Kind:        kind,
+		Location:    location,
+		Breaking:    breaking,
+		Description: description,
+	})
+}
+
+func (c *comparison) operation(key string, before, after openAPIOperation) {
+	if after.Deprecated && !before.Deprecated {
+		c.add(key, ChangeDeprecatedOperation, "", false, "operation was deprecated")
+	}
+
+	// Parameters
+	oldParameters := parametersOnKey(before.Parameters)
+	newParameters := parametersOnKey(after.Parameters)
+	for _, name := range sortedKeys(newParameters) {
+		newParameter := newParameters[name]
+		oldParameter, found := oldParameters[name]
+		location := "parameter " + name
+		switch {
+		case !found && newParameter.Required:
+			c.add(key, ChangeNewRequiredParameter, location, true, "required parameter was added")
+		case !found:
+			c.add(key, ChangeAddedParameter, location, false, "optional parameter was added")
+		case newParameter.Required && !oldParameter.Required:
+			c.add(key, ChangeNewRequiredParameter, location, true, "parameter became required")
+		}
+		if found {
+			c.schema(key, location, oldParameter.schema(), newParameter.schema(), request)
+		}
+	}
+	for _, name := range sortedKeys(oldParameters) {
+		if _, found := newParameters[name]; !found {
+			// Clients that still send the parameter silently lose its effect
+			c.add(key, ChangeRemovedParameter, "parameter "+name, true, "parameter was removed")
+		}
+	}
+
+	// Request body
+	oldRequest, oldFound := before.requestSchema()
+	newRequest, newFound := after.requestSchema()
+	switch {
+	case oldFound && newFound:
+		if after.requestBodyRequired() && !before.requestBodyRequired() {
+			c.add(key, ChangeNewRequiredField, "request", true, "request body became required")
+		}
+		c.schema(key, "request", oldRequest, newRequest, request)
+	case newFound && after.requestBodyRequired():
+		c.add(key, ChangeAddedRequestBody, "request", true, "required request body was added")
+	case newFound:
+		c.add(key, ChangeAddedRequestBody, "request", false, "optional request body was added")
+	case oldFound:
+		// Clients that still send the body silently lose its effect
+		c.add(key, ChangeRemovedRequestBody, "request", true, "request body was removed")
+	}
+
+	// Responses
+	for _, status := range sortedKeys(before.Responses) {
+		if _, found := after.Responses[status]; !found {
+			// Clients may depend on the status, e.g. a 201 that became a 200
+			c.add(key, ChangeRemovedResponse, "response "+status, true, "response status was removed")
+			continue
+		}
+		oldResponse, oldFound := before.responseSchema(status)
+		newResponse, newFound := after.responseSchema(status)
+		if oldFound && newFound {
+			c.schema(key, "response "+status, oldResponse, newResponse, response)
+		}
+	}
+	for _, status := range sortedKeys(after.Responses) {
+		if _, found := before.Responses[status]; !found {
+			c.add(key, ChangeAddedResponse, "response "+status, false, "response status was added")
+		}
+	}
+}
+
+func (c *comparison) schema(operation, location string, before, after openAPISchema, dir direction) {
+	if before.Ref != "" {
+		// Prevent endless recursion on recursive schemas
+		ref := before.Ref + "|" + after.Ref
+		if c.visiting[ref] {
+			return
+		}
+		c.visiting[ref] = true
+		defer delete(c.visiting, ref)
+	}
+
+	before = c.before.resolve(before)
+	after = c.after.resolve(after)
+
+	oldType, newType := before.typeName(), after.typeName()
+	if oldType != "" && newType != "" && oldType != newType {
+		// The fields below are not compared: they belong to a different type and the change is already breaking
+		c.add(operation, ChangeChangedType, location, true, fmt.Sprintf("type changed from %s to %s", oldType, newType))
+		return
+	}
+
+	removed, added := enumDifference(before.Enum, after.Enum)
+	if len(removed) > 0 && len(before.Enum) > 0 && len(after.Enum) > 0 {
+		// Clients may send values the server no longer accepts
+		c.add(operation, ChangeNarrowedEnum, location, dir == request, "enum values removed: "+strings.Join(removed, ", "))
+	}
+	if len(added) > 0 && len(before.Enum) > 0 {
+		// Clients may receive values they do not know
+		c.add(operation, ChangeWidenedEnum, location, dir == response, "enum values added: "+strings.Join(added, ", "))
+	}
+
+	if before.Items != nil && after.Items != nil {
+		c.schema(operation, location+"[]", *before.Items, *after.Items, dir)
+	}
+
+	for _, name := range sortedKeys(before.Properties) {
+		fieldLocation := location + "." + name
+		newProperty, found := after.Properties[name]
+		if !found {
+			c.add(operation, ChangeRemovedField, fieldLocation, dir == response, "field was removed")
+			continue
+		}
+		if dir == request && after.isRequired(name) && !before.isRequired(name) {
+			c.add(operation, ChangeNewRequiredField, fieldLocation, true, "field became required")
+		}
+		c.schema(operation, fieldLocation, before.Properties[name], newProperty, dir)
+	}
+	for _, name := range sortedKeys(after.Properties) {
+		if _, found := before.Properties[name]; found {
+			continue
+		}
+		fieldLocation := location + "." + name
+		if dir == request && after.isRequired(name) {
+			c.add(operation, ChangeNewRequiredField, fieldLocation, true, "required field was added")
+		} else {
+			c.add(operation, ChangeAddedField, fieldLocation, false, "field was added")
+		}
+	}
+}
+
+func (s OpenAPISpec) resolve(schema openAPISchema) openAPISchema {
+	for i := 0; schema.Ref != "" && i < 10; i++ {
+		resolved, found := s.schemas[schema.Ref[strings.LastIndex(schema.Ref, "/")+1:]]
+		if !found {
+			break
+		}
+		schema = resolved
+	}
+	return schema
+}
+
+func (s openAPISchema) typeName() string {
+	if s.Type == "" && len(s.Properties) > 0 {
+		return "object"
+	}
+	return s.Type
+}
+
+func (s openAPISchema) isRequired(name string) bool {
+	return slices.Contains(s.Required, name)
+}
+
+func (p openAPIParameter) schema() openAPISchema {
+	if p.Type != "" {
+		return openAPISchema{Type: p.Type, Enum: p.Enum}
+	}
+	return p.Schema
+}
+
+func (op openAPIOperation) requestSchema() (openAPISchema, bool) {
+	if mediaTypes := sortedKeys(op.RequestBody.Content); len(mediaTypes) > 0 {
+		return op.RequestBody.Content[mediaTypes[0]].Schema, true
+	}
+	for _, parameter := range op.Parameters {
+		if parameter.In == "body" {
+			return parameter.Schema, true
+		}
+	}
+	return openAPISchema{}, false
+}
+
+func (op openAPIOperation) requestBodyRequired() bool {
+	if len(op.RequestBody.Content) > 0 {
+		return op.RequestBody.Required
+	}
+	for _, parameter := range op.Parameters {
+		if parameter.In == "body" {
+			return parameter.Required
+		}
+	}
+	return false
+}
+
+func (op openAPIOperation) responseSchema(status string) (openAPISchema, bool) {
+	resp, found := op.Responses[status]
+	if !found {
+		return openAPISchema{}, false
+	}
+	if resp.Schema.Ref != "" || resp.Schema.Type != "" {
+		return resp.Schema, true
+	}
+	if mediaTypes := sortedKeys(resp.Content); len(mediaTypes) > 0 {
+		return resp.Content[mediaTypes[0]].Schema, true
+	}
+	return openAPISchema{}, false
+}
+
+func parametersOnKey(parameters []openAPIParameter) map[string]openAPIParameter {
+	result := map[string]openAPIParameter{}
+	for _, parameter := range parameters {
+		if parameter.In == "body" {
+			continue
+		}
+		result[parameter.In+" "+parameter.Name] = parameter
+	}
+	return result
+}
+
+func enumDifference(before, after []interface{}) (removed, added []string) {
+	oldValues := map[string]bool{}
+	for _, value := range before {
+		oldValues[fmt.Sprint(value)] = true
+	}
+	newValues := map[string]bool{}
+	for _, value := range after {
+		newValues[fmt.Sprint(value)] = true
+	}
+	for value := range oldValues {
+		if !newValues[value] {
+			removed = append(removed, value)
+		}
+	}
+	for value := range newValues {
+		if !oldValues[value] {
+			added = append(added, value)
+		}
+	}
+	sort.Strings(removed)
+	sort.Strings(added)
+	return removed, added
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
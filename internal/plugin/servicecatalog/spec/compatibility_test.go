@@ -0,0 +1,195 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const oldCompanyAPI = `
+openapi: 3.0.1
+paths:
+  /companies:
+    get:
+      operationId: listCompanies
+      parameters:
+        - {name: pageSize, in: query, schema: {type: integer}}
+      responses:
+        "200":
+          content:
+            application/json:
+              schema: {$ref: '#/components/schemas/Company'}
+    post:
+      operationId: createCompany
+      requestBody:
+        content:
+          application/json:
+            schema: {$ref: '#/components/schemas/CreateCompanyRequest'}
+  /companies/{companyId}:
+    delete:
+      operationId: deleteCompany
+components:
+  schemas:
+    Company:
+      type: object
+      properties:
+        id: {type: string}
+        status: {type: string, enum: [active, closed]}
+        parent: {$ref: '#/components/schemas/Company'}
+    CreateCompanyRequest:
+      type: object
+      required: [name]
+      properties:
+        name: {type: string}
+        size: {type: integer}
+        region: {type: string, enum: [EU, US, APAC]}
+`
+
+const newCompanyAPI = `
+openapi: 3.0.1
+paths:
+  /companies:
+    get:
+      operationId: listCompanies
+      deprecated: true
+      parameters:
+        - {name: pageSize, in: query, schema: {type: integer}}
+        - {name: country, in: query, required: true, schema: {type: string}}
+      responses:
+        "200":
+          content:
+            application/json:
+              schema: {$ref: '#/components/schemas/Company'}
+    post:
+      operationId: createCompany
+      requestBody:
+        content:
+          application/json:
+            schema: {$ref: '#/components/schemas/CreateCompanyRequest'}
+  /companies/{companyId}/close:
+    post:
+      operationId: closeCompany
+components:
+  schemas:
+    Company:
+      type: object
+      properties:
+        id: {type: string}
+        status: {type: string, enum: [active, closed, suspended]}
+        parent: {$ref: '#/components/schemas/Company'}
+        createdAt: {type: string}
+    CreateCompanyRequest:
+      type: object
+      required: [name, legalName]
+      properties:
+        name: {type: string}
+        legalName: {type: string}
+        size: {type: string}
+        region: {type: string, enum: [EU, US]}
+`
+
+func TestCompareOpenAPI(t *testing.T) {
+	before, err := ParseOpenAPI([]byte(oldCompanyAPI))
+	assert.NoError(t, err)
+	after, err := ParseOpenAPI([]byte(newCompanyAPI))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []Change{
+		{Operation: "DELETE /companies/{companyId}", Kind: ChangeRemovedOperation, Breaking: true, Description: "operation was removed"},
+		{Operation: "GET /companies", Kind: ChangeNewRequiredParameter, Location: "parameter query country", Breaking: true, Description: "required parameter was added"},
+		{Operation: "GET /companies", Kind: ChangeWidenedEnum, Location: "response 200.status", Breaking: true, Description: "enum values added: suspended"},
+		{Operation: "POST /companies", Kind: ChangeNewRequiredField, Location: "request.legalName", Breaking: true, Description: "required field was added"},
+		{Operation: "POST /companies", Kind: ChangeNarrowedEnum, Location: "request.region", Breaking: true, Description: "enum values removed: APAC"},
+		{Operation: "POST /companies", Kind: ChangeChangedType, Location: "request.size", Breaking: true, Description: "type changed from integer to string"},
+		{Operation: "GET /companies", Kind: ChangeDeprecatedOperation, Breaking: false, Description: "operation was deprecated"},
+		{Operation: "GET /companies", Kind: ChangeAddedField, Location: "response 200.createdAt", Breaking: false, Description: "field was added"},
+		{Operation: "POST /companies/{companyId}/close", Kind: ChangeAddedOperation, Breaking: false, Description: "operation was added"},
+	}, CompareOpenAPI(before, after))
+}
+
+func TestCompareOpenAPIUnchanged(t *testing.T) {
+	spec, err := ParseOpenAPI([]byte(oldCompanyAPI))
+	assert.NoError(t, err)
+
+	assert.Empty(t, CompareOpenAPI(spec, spec))
+}
+
+const oldOrderAPI = `
+openapi: 3.0.1
+paths:
+  /orders:
+    get:
+      operationId: listOrders
+      parameters:
+        - {name: status, in: query, schema: {type: string}}
+      responses:
+        "200":
+          content:
+            application/json:
+              schema: {$ref: '#/components/schemas/Order'}
+        "404":
+          description: not found
+    post:
+      operationId: createOrder
+    put:
+      operationId: replaceOrder
+      requestBody:
+        content:
+          application/json:
+            schema: {$ref: '#/components/schemas/Order'}
+components:
+  schemas:
+    Order:
+      type: object
+      properties:
+        amount:
+          type: object
+          properties:
+            value: {type: integer}
+`
+
+const newOrderAPI = `
+openapi: 3.0.1
+paths:
+  /orders:
+    get:
+      operationId: listOrders
+      responses:
+        "200":
+          content:
+            application/json:
+              schema: {$ref: '#/components/schemas/Order'}
+        "206":
+          description: partial content
+    post:
+      operationId: createOrder
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema: {$ref: '#/components/schemas/Order'}
+    put:
+      operationId: replaceOrder
+components:
+  schemas:
+    Order:
+      type: object
+      properties:
+        amount: {type: string}
+`
+
+func TestCompareOpenAPIRequestBodiesAndResponses(t *testing.T) {
+	before, err := ParseOpenAPI([]byte(oldOrderAPI))
+	assert.NoError(t, err)
+	after, err := ParseOpenAPI([]byte(newOrderAPI))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []Change{
+		{Operation: "GET /orders", Kind: ChangeRemovedParameter, Location: "parameter query status", Breaking: true, Description: "parameter was removed"},
+		{Operation: "GET /orders", Kind: ChangeChangedType, Location: "response 200.amount", Breaking: true, Description: "type changed from object to string"},
+		{Operation: "GET /orders", Kind: ChangeRemovedResponse, Location: "response 404", Breaking: true, Description: "response status was removed"},
+		{Operation: "POST /orders", Kind: ChangeAddedRequestBody, Location: "request", Breaking: true, Description: "required request body was added"},
+		{Operation: "PUT /orders", Kind: ChangeRemovedRequestBody, Location: "request", Breaking: true, Description: "request body was removed"},
+		{Operation: "GET /orders", Kind: ChangeAddedResponse, Location: "response 206", Breaking: false, Description: "response status was added"},
+	}, CompareOpenAPI(before, after))
+}
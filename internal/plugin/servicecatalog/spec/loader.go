@@ -0,0 +1,51 @@
+package spec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Loader defines the interface for reading specification files that the catalog refers to.
+//
+//go:generate go tool mockgen -source=loader.go -destination=mock_loader.go -package=spec Loader
+type Loader interface {
+	Load(ctx context.Context, path string) ([]byte, bool, error)
+}
+
+type fileLoader struct {
+	rootDir string
+}
+
+// NewLoader creates a Loader that resolves specification paths relative to the given root directory (=a source checkout).
+func NewLoader(rootDir string) Loader {
+	return &fileLoader{
+		rootDir: rootDir,
+	}
+}
+
+// Load reads the specification file with the given path.
+func (l *fileLoader) Load(ctx context.Context, path string) ([]byte, bool, error) {
+	if l.rootDir == "" {
+		return nil, false, fmt.Errorf("specification directory not configured")
+	}
+
+	filename := filepath.Join(l.rootDir, filepath.FromSlash(path))
+	relative, err := filepath.Rel(l.rootDir, filename)
+	if err != nil || relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+		return nil, false, fmt.Errorf("specification path %s outside specification directory", path)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("read specification error: %w", err)
+	}
+
+	return data, true, nil
+}
@@ -0,0 +1,38 @@
+package spec
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	err := os.MkdirAll(filepath.Join(rootDir, "api", "specs"), 0o755)
+	assert.NoError(t, err)
+	err = os.WriteFile(filepath.Join(rootDir, "api", "specs", "Service-v1.json"), []byte(`{}`), 0o644)
+	assert.NoError(t, err)
+
+	loader := NewLoader(rootDir)
+
+	data, exists, err := loader.Load(ctx, "api/specs/Service-v1.json")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, `{}`, string(data))
+
+	_, exists, err = loader.Load(ctx, "api/specs/Service-v2.json")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	_, _, err = loader.Load(ctx, "../outside.json")
+	assert.Error(t, err)
+}
+
+func TestLoadNotConfigured(t *testing.T) {
+	_, _, err := NewLoader("").Load(context.Background(), "api/specs/Service-v1.json")
+	assert.Error(t, err)
+}
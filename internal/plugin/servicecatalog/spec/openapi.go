@@ -0,0 +1,206 @@
+package spec
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OpenAPISpec is the parsed, operation-level view of an OpenAPI (or Swagger 2.0) specification.
+type OpenAPISpec struct {
+	Title      string      `json:"title,omitempty"`
+	Version    string      `json:"version,omitempty"`
+	Operations []Operation `json:"operations"`
+
+	// details needed to compare specifications
+	operations map[string]openAPIOperation // keyed on Operation.Key
+	schemas    map[string]openAPISchema
+}
+
+// Operation is a single HTTP operation of an OpenAPI specification.
+type Operation struct {
+	OperationID     string            `json:"operationID,omitempty"`
+	Method          string            `json:"method"`
+	Path            string            `json:"path"`
+	Summary         string            `json:"summary,omitempty"`
+	Tags            []string          `json:"tags,omitempty"`
+	Deprecated      bool              `json:"deprecated,omitempty"`
+	RequestSchemas  []string          `json:"requestSchemas,omitempty"`
+	ResponseSchemas map[string]string `json:"responseSchemas,omitempty"` // keyed on status code
+}
+
+// SchemaSize returns the number of named schemas of the specification plus the number of properties of these schemas.
+func (s OpenAPISpec) SchemaSize() int {
+	size := 0
+	for _, schema := range s.schemas {
+		size += 1 + len(schema.Properties)
+	}
+	return size
+}
+
+// Key identifies an operation by its HTTP method and path, for operations without an operationId.
+func (o Operation) Key() string {
+	return o.Method + " " + o.Path
+}
+
+// Matches tells whether the operation is identified by the given operationId or "METHOD /path".
+func (o Operation) Matches(id string) bool {
+	return strings.EqualFold(o.OperationID, id) || strings.EqualFold(o.Key(), id)
+}
+
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+type openAPIDocument struct {
+	Info struct {
+		Title   string `yaml:"title"`
+		Version string `yaml:"version"`
+	} `yaml:"info"`
+	Paths      map[string]map[string]yaml.Node `yaml:"paths"`
+	Components struct {
+		Schemas map[string]openAPISchema `yaml:"schemas"`
+	} `yaml:"components"`
+	Definitions map[string]openAPISchema `yaml:"definitions"` // Swagger 2.0
+}
+
+type openAPIOperation struct {
+	OperationID string             `yaml:"operationId"`
+	Summary     string             `yaml:"summary"`
+	Tags        []string           `yaml:"tags"`
+	Deprecated  bool               `yaml:"deprecated"`
+	Parameters  []openAPIParameter `yaml:"parameters"`
+	RequestBody struct {
+		Required bool `yaml:"required"`
+		Content  map[string]struct {
+			Schema openAPISchema `yaml:"schema"`
+		} `yaml:"content"`
+	} `yaml:"requestBody"`
+	Responses map[string]struct {
+		Schema  openAPISchema `yaml:"schema"`
+		Content map[string]struct {
+			Schema openAPISchema `yaml:"schema"`
+		} `yaml:"content"`
+	} `yaml:"responses"`
+}
+
+type openAPIParameter struct {
+	Name     string        `yaml:"name"`
+	In       string        `yaml:"in"`
+	Required bool          `yaml:"required"`
+	Schema   openAPISchema `yaml:"schema"`
+	Type     string        `yaml:"type"` // Swagger 2.0
+	Enum     []interface{} `yaml:"enum"` // Swagger 2.0
+}
+
+type openAPISchema struct {
+	Ref        string                   `yaml:"$ref"`
+	Type       string                   `yaml:"type"`
+	Items      *openAPISchema           `yaml:"items"`
+	Properties map[string]openAPISchema `yaml:"properties"`
+	Required   []string                 `yaml:"required"`
+	Enum       []interface{}            `yaml:"enum"`
+}
+
+// name returns the name of the referenced schema, or the type for inline schemas.
+func (s openAPISchema) name() string {
+	if s.Ref != "" {
+		return s.Ref[strings.LastIndex(s.Ref, "/")+1:]
+	}
+	if s.Type == "array" && s.Items != nil {
+		if item := s.Items.name(); item != "" {
+			return item + "[]"
+		}
+	}
+	return s.Type
+}
+
+// ParseOpenAPI parses an OpenAPI 3 or Swagger 2.0 specification in JSON or YAML format.
+func ParseOpenAPI(data []byte) (OpenAPISpec, error) {
+	doc := openAPIDocument{}
+	err := yaml.Unmarshal(data, &doc)
+	if err != nil {
+		return OpenAPISpec{}, fmt.Errorf("parse openapi specification error: %w", err)
+	}
+
+	spec := OpenAPISpec{
+		Title:      doc.Info.Title,
+		Version:    doc.Info.Version,
+		Operations: []Operation{},
+		operations: map[string]openAPIOperation{},
+		schemas:    doc.Components.Schemas,
+	}
+	if spec.schemas == nil {
+		spec.schemas = doc.Definitions
+	}
+	for path, item := range doc.Paths {
+		for _, method := range httpMethods {
+			node, found := item[method]
+			if !found {
+				continue
+			}
+			op := openAPIOperation{}
+			err := node.Decode(&op)
+			if err != nil {
+				return OpenAPISpec{}, fmt.Errorf("parse operation %s %s error: %w", method, path, err)
+			}
+			operation := op.toOperation(strings.ToUpper(method), path)
+			spec.Operations = append(spec.Operations, operation)
+			spec.operations[operation.Key()] = op
+		}
+	}
+
+	sort.Slice(spec.Operations, func(i, j int) bool {
+		if spec.Operations[i].Path != spec.Operations[j].Path {
+			return spec.Operations[i].Path < spec.Operations[j].Path
+		}
+		return spec.Operations[i].Method < spec.Operations[j].Method
+	})
+
+	return spec, nil
+}
+
+func (op openAPIOperation) toOperation(method, path string) Operation {
+	operation := Operation{
+		OperationID: op.OperationID,
+		Method:      method,
+		Path:        path,
+		Summary:     op.Summary,
+		Tags:        op.Tags,
+		Deprecated:  op.Deprecated,
+	}
+
+	requestSchemas := map[string]bool{}
+	for _, media := range op.RequestBody.Content {
+		requestSchemas[media.Schema.name()] = true
+	}
+	// Swagger 2.0 passes the request body as parameter
+	for _, parameter := range op.Parameters {
+		if parameter.In == "body" {
+			requestSchemas[parameter.Schema.name()] = true
+		}
+	}
+	for name := range requestSchemas {
+		if name != "" {
+			operation.RequestSchemas = append(operation.RequestSchemas, name)
+		}
+	}
+	sort.Strings(operation.RequestSchemas)
+
+	for status, response := range op.Responses {
+		name := response.Schema.name()
+		for _, media := range response.Content {
+			if name == "" {
+				name = media.Schema.name()
+			}
+		}
+		if name != "" {
+			if operation.ResponseSchemas == nil {
+				operation.ResponseSchemas = map[string]string{}
+			}
+			operation.ResponseSchemas[status] = name
+		}
+	}
+
+	return operation
+}
@@ -0,0 +1,130 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOpenAPIv3JSON(t *testing.T) {
+	spec, err := ParseOpenAPI([]byte(`{
+		"openapi": "3.0.1",
+		"info": {"title": "Management API", "version": "3"},
+		"paths": {
+			"/companies/{companyId}": {
+				"parameters": [{"name": "companyId", "in": "path"}],
+				"get": {
+					"operationId": "getCompany",
+					"tags": ["Account - company level"],
+					"responses": {
+						"200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Company"}}}},
+						"404": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/RestServiceError"}}}}
+					}
+				}
+			},
+			"/companies": {
+				"get": {
+					"operationId": "listCompanies",
+					"deprecated": true,
+					"responses": {
+						"200": {"content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Company"}}}}}
+					}
+				},
+				"post": {
+					"summary": "Create a company",
+					"requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/CreateCompanyRequest"}}}},
+					"responses": {"204": {"description": "No content"}}
+				}
+			}
+		}
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "Management API", spec.Title)
+	assert.Equal(t, "3", spec.Version)
+	assert.Equal(t, []Operation{
+		{
+			OperationID:     "listCompanies",
+			Method:          "GET",
+			Path:            "/companies",
+			Deprecated:      true,
+			ResponseSchemas: map[string]string{"200": "Company[]"},
+		},
+		{
+			Method:         "POST",
+			Path:           "/companies",
+			Summary:        "Create a company",
+			RequestSchemas: []string{"CreateCompanyRequest"},
+		},
+		{
+			OperationID:     "getCompany",
+			Method:          "GET",
+			Path:            "/companies/{companyId}",
+			Tags:            []string{"Account - company level"},
+			ResponseSchemas: map[string]string{"200": "Company", "404": "RestServiceError"},
+		},
+	}, spec.Operations)
+
+	assert.True(t, spec.Operations[1].Matches("post /companies"))
+	assert.True(t, spec.Operations[2].Matches("getCompany"))
+	assert.False(t, spec.Operations[2].Matches("listCompanies"))
+}
+
+func TestParseSwaggerYAML(t *testing.T) {
+	spec, err := ParseOpenAPI([]byte(`
+swagger: "2.0"
+info:
+  title: Payout API
+  version: "68"
+paths:
+  /payouts:
+    post:
+      operationId: payout
+      parameters:
+        - in: body
+          name: request
+          schema:
+            $ref: '#/definitions/PayoutRequest'
+      responses:
+        "200":
+          schema:
+            $ref: '#/definitions/PayoutResponse'
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, []Operation{
+		{
+			OperationID:     "payout",
+			Method:          "POST",
+			Path:            "/payouts",
+			RequestSchemas:  []string{"PayoutRequest"},
+			ResponseSchemas: map[string]string{"200": "PayoutResponse"},
+		},
+	}, spec.Operations)
+}
+
+func TestOpenAPISchemaSize(t *testing.T) {
+	spec, err := ParseOpenAPI([]byte(`
+openapi: 3.0.1
+paths: {}
+components:
+  schemas:
+    Company:
+      type: object
+      properties:
+        id: {type: string}
+        name: {type: string}
+    Status:
+      type: string
+      enum: [active, inactive]
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, spec.SchemaSize())
+
+	spec, err = ParseOpenAPI([]byte(`{"swagger": "2.0", "paths": {}, "definitions": {"PayoutRequest": {"properties": {"amount": {"type": "integer"}}}}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, spec.SchemaSize())
+}
+
+func TestParseOpenAPIInvalid(t *testing.T) {
+	_, err := ParseOpenAPI([]byte(`{"paths": [`))
+	assert.Error(t, err)
+}
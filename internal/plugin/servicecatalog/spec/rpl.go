@@ -0,0 +1,230 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// RPLSpec is the parsed view of an RPL (internal RPC) specification.
+type RPLSpec struct {
+	Methods  []RPLMethod  `json:"methods"`
+	Messages []RPLMessage `json:"messages"`
+}
+
+// RPLMethod is a remote procedure with the names of its request and response message types.
+type RPLMethod struct {
+	Name       string `json:"name"`
+	Service    string `json:"service,omitempty"`
+	Request    string `json:"request,omitempty"`
+	Response   string `json:"response,omitempty"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+}
+
+// RPLMessage is a message type exchanged by remote procedures.
+type RPLMessage struct {
+	Name   string     `json:"name"`
+	Fields []RPLField `json:"fields"`
+}
+
+// RPLField is a field of a message type.
+type RPLField struct {
+	Name     string `json:"name"`
+	Type     string `json:"type,omitempty"`
+	Required bool   `json:"required,omitempty"`
+	Repeated bool   `json:"repeated,omitempty"`
+}
+
+// Method returns the method with the given name.
+func (s RPLSpec) Method(name string) (RPLMethod, bool) {
+	for _, method := range s.Methods {
+		if strings.EqualFold(method.Name, name) {
+			return method, true
+		}
+	}
+	return RPLMethod{}, false
+}
+
+// Message returns the message type with the given name.
+func (s RPLSpec) Message(name string) (RPLMessage, bool) {
+	for _, message := range s.Messages {
+		if message.Name == name {
+			return message, true
+		}
+	}
+	return RPLMessage{}, false
+}
+
+type rplElement struct {
+	kind    string // service, method, message, field, request, response, fieldtype or other
+	method  *RPLMethod
+	message *RPLMessage
+	field   *RPLField
+	text    strings.Builder
+}
+
+// ParseRPL parses an XML based RPL specification: services containing methods that refer to request and
+// response messages, and messages consisting of typed fields. No RPL sample or schema was available when this
+// parser was written, so the format below is an assumption. Only these element and attribute names are recognized:
+//
+//	<service name="...">
+//		<method name="..." request="..." response="..." deprecated="true"/>
+//		<method name="..."><request type="..."/><response>...</response></method>
+//	</service>
+//	<message name="...">
+//		<field name="..." type="..." required="true" repeated="true"/>
+//		<field name="..." minOccurs="1" maxOccurs="unbounded"><type>...</type></field>
+//	</message>
+func ParseRPL(data []byte) (RPLSpec, error) {
+	spec := RPLSpec{
+		Methods:  []RPLMethod{},
+		Messages: []RPLMessage{},
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+	stack := []*rplElement{}
+	service := ""
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return RPLSpec{}, fmt.Errorf("parse rpl specification error: %w", err)
+		}
+
+		var parent *rplElement
+		if len(stack) > 0 {
+			parent = stack[len(stack)-1]
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			element := &rplElement{kind: "other"}
+			name := strings.ToLower(t.Name.Local)
+			switch {
+			case parent != nil && parent.kind == "method" && name == "request":
+				element.kind = "request"
+				element.method = parent.method
+				if typeName := attr(t, "type"); typeName != "" {
+					parent.method.Request = typeName
+				}
+			case parent != nil && parent.kind == "method" && name == "response":
+				element.kind = "response"
+				element.method = parent.method
+				if typeName := attr(t, "type"); typeName != "" {
+					parent.method.Response = typeName
+				}
+			case parent != nil && parent.kind == "field" && name == "type":
+				element.kind = "fieldtype"
+				element.field = parent.field
+			case parent != nil && parent.kind == "message" && name == "field":
+				element.kind = "field"
+				element.field = &RPLField{
+					Name:     attr(t, "name"),
+					Type:     attr(t, "type"),
+					Required: isTrue(attr(t, "required")) || attr(t, "minoccurs") == "1",
+					Repeated: isTrue(attr(t, "repeated")) || attr(t, "maxoccurs") == "unbounded",
+				}
+			case name == "service" && !insideMethodOrMessage(stack):
+				element.kind = "service"
+				service = attr(t, "name")
+			case name == "method" && !insideMethodOrMessage(stack):
+				element.kind = "method"
+				element.method = &RPLMethod{
+					Name:       attr(t, "name"),
+					Service:    service,
+					Request:    attr(t, "request"),
+					Response:   attr(t, "response"),
+					Deprecated: isTrue(attr(t, "deprecated")),
+				}
+			case name == "message" && !insideMethodOrMessage(stack) && attr(t, "name") != "":
+				element.kind = "message"
+				element.message = &RPLMessage{
+					Name:   attr(t, "name"),
+					Fields: []RPLField{},
+				}
+			}
+			stack = append(stack, element)
+
+		case xml.CharData:
+			if parent != nil {
+				parent.text.Write(t)
+			}
+
+		case xml.EndElement:
+			if parent == nil {
+				continue
+			}
+			stack = stack[:len(stack)-1]
+			text := strings.TrimSpace(parent.text.String())
+			switch parent.kind {
+			case "request":
+				if parent.method.Request == "" {
+					parent.method.Request = text
+				}
+			case "response":
+				if parent.method.Response == "" {
+					parent.method.Response = text
+				}
+			case "fieldtype":
+				if parent.field.Type == "" {
+					parent.field.Type = text
+				}
+			case "field":
+				if parent.field.Name != "" && len(stack) > 0 {
+					message := stack[len(stack)-1].message
+					message.Fields = append(message.Fields, *parent.field)
+				}
+			case "service":
+				service = ""
+			case "method":
+				if parent.method.Name != "" {
+					spec.Methods = append(spec.Methods, *parent.method)
+				}
+			case "message":
+				spec.Messages = append(spec.Messages, *parent.message)
+			}
+		}
+	}
+
+	sort.SliceStable(spec.Methods, func(i, j int) bool {
+		if spec.Methods[i].Service != spec.Methods[j].Service {
+			return spec.Methods[i].Service < spec.Methods[j].Service
+		}
+		return spec.Methods[i].Name < spec.Methods[j].Name
+	})
+	sort.SliceStable(spec.Messages, func(i, j int) bool {
+		return spec.Messages[i].Name < spec.Messages[j].Name
+	})
+
+	return spec, nil
+}
+
+func insideMethodOrMessage(stack []*rplElement) bool {
+	for _, element := range stack {
+		if element.kind == "method" || element.kind == "message" {
+			return true
+		}
+	}
+	return false
+}
+
+// attr returns the value of the given attribute, ignoring case.
+func attr(element xml.StartElement, name string) string {
+	for _, a := range element.Attr {
+		if strings.EqualFold(a.Name.Local, name) {
+			return strings.TrimSpace(a.Value)
+		}
+	}
+	return ""
+}
+
+func isTrue(value string) bool {
+	return strings.EqualFold(value, "true")
+}
@@ -0,0 +1,67 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRPL(t *testing.T) {
+	spec, err := ParseRPL([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<rpl>
+	<service name="BinLookupService">
+		<method name="getCostEstimate" request="CostEstimateRequest" response="CostEstimateResponse"/>
+		<method name="get3dsAvailability" deprecated="true">
+			<request type="ThreeDSAvailabilityRequest"/>
+			<response>ThreeDSAvailabilityResponse</response>
+		</method>
+	</service>
+	<message name="CostEstimateRequest">
+		<field name="amount" type="Amount" required="true"/>
+		<field name="merchantAccount" type="String" required="true"/>
+		<field name="shopperInteraction" type="String"/>
+	</message>
+	<message name="ThreeDSAvailabilityRequest">
+		<field name="brands" maxOccurs="unbounded"><type>String</type></field>
+	</message>
+</rpl>`))
+	assert.NoError(t, err)
+	assert.Equal(t, []RPLMethod{
+		{Name: "get3dsAvailability", Service: "BinLookupService", Request: "ThreeDSAvailabilityRequest", Response: "ThreeDSAvailabilityResponse", Deprecated: true},
+		{Name: "getCostEstimate", Service: "BinLookupService", Request: "CostEstimateRequest", Response: "CostEstimateResponse"},
+	}, spec.Methods)
+	assert.Equal(t, []RPLMessage{
+		{Name: "CostEstimateRequest", Fields: []RPLField{
+			{Name: "amount", Type: "Amount", Required: true},
+			{Name: "merchantAccount", Type: "String", Required: true},
+			{Name: "shopperInteraction", Type: "String"},
+		}},
+		{Name: "ThreeDSAvailabilityRequest", Fields: []RPLField{
+			{Name: "brands", Type: "String", Repeated: true},
+		}},
+	}, spec.Messages)
+
+	method, found := spec.Method("getcostestimate")
+	assert.True(t, found)
+	assert.Equal(t, "CostEstimateRequest", method.Request)
+	_, found = spec.Message("Unknown")
+	assert.False(t, found)
+}
+
+func TestParseRPLInvalid(t *testing.T) {
+	_, err := ParseRPL([]byte(`<rpl><method name="x">`))
+	assert.Error(t, err)
+}
+
+func TestParseRPLIgnoresUnknownElements(t *testing.T) {
+	spec, err := ParseRPL([]byte(`<rpl>
+	<service name="BinLookupService">
+		<operation name="getCostEstimate"/>
+		<method name="getBrands"><in>BrandsRequest</in><out>BrandsResponse</out></method>
+	</service>
+	<struct name="BrandsRequest"><member name="currency" type="String"/></struct>
+</rpl>`))
+	assert.NoError(t, err)
+	assert.Equal(t, []RPLMethod{{Name: "getBrands", Service: "BinLookupService"}}, spec.Methods)
+	assert.Empty(t, spec.Messages)
+}
@@ -11,36 +11,47 @@ import (
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
 )
 
+// CandidateList holds the entities matching a keyword, ranked across all categories.
+type CandidateList struct {
+	Hits []search.Hit `json:"hits"`
+}
+
 // NewSuggestCandidatesTool returns the MCP tool definition and its handler for listing interfaces.
 func (h *mcpHandler) suggestCandidatesTool() server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool(
 			"suggest_candidates",
-			mcp.WithDescription("Suggest matching modules, interfaces, databases, or teams based on user input."),
-			mcp.WithString("keyword", mcp.Required(), mcp.Description("The keyword to search modules, interfaces, databases, or teams for.")),
-			mcp.WithNumber("limit_to", mcp.Description("Maximum number of results per category to return.")),
+			mcp.WithDescription("Suggest matching modules, interfaces, databases, teams, flows, methods, kinds or jobs based on user input. "+
+				"Returns a single list ranked on match quality across all categories: each hit has its category, a score (1 for an exact match) and the character ranges of the ID that match."),
+			mcp.WithString("keyword", mcp.Required(), mcp.Description("The keyword to search modules, interfaces, databases, teams, flows, methods, kinds or jobs for.")),
+			mcp.WithNumber("limit_to", mcp.Description("Maximum number of results to return (default 10).")),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
-			mcp.WithOutputSchema[search.Result](),
+			mcp.WithOutputSchema[CandidateList](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// extract params
 			keyword, err := request.RequireString("keyword")
 			if err != nil {
-				resp.InvalidInput(ctx, "Missing keyword",
-					"keyword",
-					"Use a valid keyword")
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, "Missing keyword",
+						"keyword",
+						"Use a valid keyword")), nil
 			}
 			limit := request.GetInt("limit_to", 10)
+			if limit < 1 {
+				return mcp.NewToolResultError(
+					resp.InvalidInput(ctx, fmt.Sprintf("Invalid limit_to %d", limit),
+						"limit_to",
+						"Use a positive number")), nil
+			}
 
 			// call business logic
 			searchResult := h.idx.Search(ctx, keyword, limit)
-			if err != nil {
-				return mcp.NewToolResultError(
-					resp.InternalError(ctx,
-						fmt.Sprintf("error searching for candidates like %s: %s", keyword, err))), nil
-			}
 
-			return mcp.NewToolResultJSON[search.Result](searchResult)
+			return mcp.NewToolResultJSON[CandidateList](CandidateList{
+				Hits: searchResult.Hits,
+			})
 		},
 	}
 }
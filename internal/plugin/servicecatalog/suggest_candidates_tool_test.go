@@ -1,10 +1,14 @@
 package servicecatalog
 
 import (
+	"context"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
 )
 
 func TestSuggestCandidatesSuccess(t *testing.T) {
@@ -20,6 +24,58 @@ func TestSuggestCandidatesSuccess(t *testing.T) {
 	// then
 	assert.NoError(t, err)
 	textResult := result.Content[0].(mcp.TextContent)
-	assert.Contains(t, textResult.Text, `{"Modules":["partner","partner-jobs"`)
-	assert.Contains(t, textResult.Text, `"Teams":["partner-experience"`)
+	assert.Contains(t, textResult.Text, `{"hits":[{"category":"module","id":"partner","score":1,"matchedRanges":[{"start":0,"end":7}]}`)
+	assert.Contains(t, textResult.Text, `{"category":"database","id":"partner","score":1,`)
+}
+
+func TestSuggestCandidatesRanked(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idx := search.NewMockIndex(ctrl)
+	idx.EXPECT().Search(gomock.Any(), "partner", 2).Return(search.Result{
+		Modules: []string{"partner-jobs"},
+		Teams:   []string{"partner"},
+		Hits: []search.Hit{
+			{Category: search.CategoryTeam, ID: "partner", Score: 1, MatchedRanges: []search.Range{{Start: 0, End: 7}}},
+			{Category: search.CategoryModule, ID: "partner-jobs", Score: 0.997, MatchedRanges: []search.Range{{Start: 0, End: 7}}},
+		},
+	})
+
+	// When
+	result, err := NewMCPHandler(nil, idx).suggestCandidatesTool().Handler(context.Background(),
+		createRequest("suggest_candidates", map[string]interface{}{
+			"keyword":  "partner",
+			"limit_to": 2,
+		}))
+
+	// Then
+	assert.NoError(t, err)
+	textResult := result.Content[0].(mcp.TextContent)
+	assert.Equal(t, `{"hits":[{"category":"team","id":"partner","score":1,"matchedRanges":[{"start":0,"end":7}]},{"category":"module","id":"partner-jobs","score":0.997,"matchedRanges":[{"start":0,"end":7}]}]}`, textResult.Text)
+}
+
+func TestSuggestCandidatesMissingKeyword(t *testing.T) {
+	// When
+	result, err := NewMCPHandler(nil, nil).suggestCandidatesTool().Handler(context.Background(),
+		createRequest("suggest_candidates", nil))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, "Missing keyword")
+}
+
+func TestSuggestCandidatesInvalidLimit(t *testing.T) {
+	// When
+	result, err := NewMCPHandler(nil, nil).suggestCandidatesTool().Handler(context.Background(),
+		createRequest("suggest_candidates", map[string]interface{}{
+			"keyword":  "partner",
+			"limit_to": -1,
+		}))
+
+	// Then
+	assert.NoError(t, err)
+	expectError(t, result, `"status": "invalid_input"`)
+	expectError(t, result, "Invalid limit_to -1")
 }
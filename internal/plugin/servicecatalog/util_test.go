@@ -45,3 +45,11 @@ func expectError(t *testing.T, result *mcp.CallToolResult, errorText string) {
 	assert.True(t, ok)
 	assert.Contains(t, content.Text, errorText)
 }
+
+func stringPointer(val string) *string {
+	return &val
+}
+
+func intPointer(val int) *int {
+	return &val
+}
@@ -16,6 +16,7 @@ func (h *mcpHandler) listSLOsOnPromQLModule() server.ServerTool {
 			"list_slos_on_module",
 			mcp.WithDescription("Search all SLO's based on their module"),
 			mcp.WithString("module_id", mcp.Required(), mcp.Description("Name of the module to list SLOs for")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[List](),
@@ -29,6 +30,11 @@ func (h *mcpHandler) listSLOsOnPromQLModule() server.ServerTool {
 					"Use a valid module_id")), nil
 			}
 
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+
 			// call business logic
 			slos, exists, err := h.repo.ListSLOsByPromQLModule(ctx, moduleID)
 			if err != nil {
@@ -44,8 +50,10 @@ func (h *mcpHandler) listSLOsOnPromQLModule() server.ServerTool {
 
 			}
 
+			slos, nextCursor := resp.Paginate(slos, page)
 			return mcp.NewToolResultJSON[List](List{
-				SLOs: slos,
+				SLOs:       slos,
+				NextCursor: nextCursor,
 			})
 		},
 	}
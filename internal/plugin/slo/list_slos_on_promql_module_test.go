@@ -40,6 +40,25 @@ func TestListSLOsOnPromQLModuleTool(t *testing.T) {
 		assert.Contains(t, textResult, `"uid":"slo1",`)
 	})
 
+	t.Run("Paged list", func(t *testing.T) {
+		moduleID := "paged-app"
+		expectedSLOs := []repo.SLO{
+			{UID: "slo1", Application: moduleID},
+			{UID: "slo2", Application: moduleID},
+		}
+		repoMock.EXPECT().ListSLOsByPromQLModule(ctx, moduleID).Return(expectedSLOs, true, nil).Times(1)
+
+		req := createRequest("list_slos_on_module", map[string]interface{}{"module_id": moduleID, "page_size": 1})
+		result, err := tool.Handler(ctx, req)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+
+		textResult := result.Content[0].(mcp.TextContent).Text
+		assert.Contains(t, textResult, `"uid":"slo1",`)
+		assert.NotContains(t, textResult, `"uid":"slo2",`)
+		assert.Contains(t, textResult, `"next_cursor":"`)
+	})
+
 	t.Run("SLOs for module not found", func(t *testing.T) {
 		moduleID := "nonexistent-module"
 		repoMock.EXPECT().ListSLOsByPromQLModule(ctx, moduleID).Return([]repo.SLO{}, false, nil).Times(1)
@@ -16,6 +16,7 @@ func (h *mcpHandler) listSLOsOnPromQLWebService() server.ServerTool {
 			"list_slos_on_service",
 			mcp.WithDescription("Search all SLO's based on a web service"),
 			mcp.WithString("service-name", mcp.Required(), mcp.Description("Name of the web-service to list SLOs for")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[List](),
@@ -29,6 +30,11 @@ func (h *mcpHandler) listSLOsOnPromQLWebService() server.ServerTool {
 					"Use a valid service-name")), nil
 			}
 
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+
 			// call business logic
 			slos, exists, err := h.repo.ListSLOsByPromQLService(ctx, serviceName)
 			if err != nil {
@@ -44,8 +50,10 @@ func (h *mcpHandler) listSLOsOnPromQLWebService() server.ServerTool {
 
 			}
 
+			slos, nextCursor := resp.Paginate(slos, page)
 			return mcp.NewToolResultJSON[List](List{
-				SLOs: slos,
+				SLOs:       slos,
+				NextCursor: nextCursor,
 			})
 		},
 	}
@@ -34,6 +34,9 @@ func (h *mcpHandler) RegisterAllHandlers(ctx context.Context, s *server.MCPServe
 	s.AddResources(
 		h.sloResource(),
 	)
+	s.AddResourceTemplates(
+		h.sloResourceTemplate(),
+	)
 
 	s.AddPrompts(
 		h.sloPrompt(),
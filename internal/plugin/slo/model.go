@@ -6,5 +6,6 @@ import (
 
 // List wraps a list into a single object (because the API does not allow lists)
 type List struct {
-	SLOs []repo.SLO `json:"slos"`
+	SLOs       []repo.SLO `json:"slos"`
+	NextCursor string     `json:"next_cursor,omitempty"`
 }
@@ -18,6 +18,7 @@ func (h *mcpHandler) searchSLOs() server.ServerTool {
 			mcp.WithString("category", mcp.Required(), mcp.Description("Category to search on: Must be one of 'team', 'application', 'webapp', 'service', 'component' or 'method'"),
 				mcp.WithStringEnumItems([]string{"team", "application", "webapp", "service", "component", "method"})),
 			mcp.WithString("keyword", mcp.Required(), mcp.Description("The keyword to list SLOs for")),
+			resp.WithPagination(),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(false),
 			mcp.WithOutputSchema[List](),
@@ -37,6 +38,11 @@ func (h *mcpHandler) searchSLOs() server.ServerTool {
 					"Use a keyword")), nil
 			}
 
+			page, errResult := resp.PageFromRequest(ctx, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+
 			// call business logic
 			slos, exists, err := h.repo.SearchSLOs(ctx, category, keyword)
 			if err != nil {
@@ -52,7 +58,11 @@ func (h *mcpHandler) searchSLOs() server.ServerTool {
 
 			}
 
-			return mcp.NewToolResultJSON[List](List{SLOs: slos})
+			slos, nextCursor := resp.Paginate(slos, page)
+			return mcp.NewToolResultJSON[List](List{
+				SLOs:       slos,
+				NextCursor: nextCursor,
+			})
 		},
 	}
 }
@@ -18,20 +18,45 @@ func (h *mcpHandler) sloResource() server.ServerResource {
 			"List of slo for all applications",
 			mcp.WithMIMEType("application/json"),
 		),
-		Handler: func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
-			// call business logic
-			slos, err := h.repo.ListSLOs(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("error listing slos: %s", err)
-			}
-
-			return []mcp.ResourceContents{
-				mcp.TextResourceContents{
-					URI:      request.Params.URI,
-					MIMEType: "application/json",
-					Text:     resp.Success(ctx, slos),
-				},
-			}, nil
-		},
+		Handler: h.readSLOResource,
+	}
+}
+
+// NewSLOResourceTemplate returns the MCP resource template contract and handler for paging through the slos.
+func (h *mcpHandler) sloResourceTemplate() server.ServerResourceTemplate {
+	return server.ServerResourceTemplate{
+		Template: mcp.NewResourceTemplate(
+			"catalog://slos{?page_size,cursor}",
+			"Page of slo for all applications",
+			mcp.WithTemplateDescription("Use the next_cursor of the previous page as cursor to get the next page of slos"),
+			mcp.WithTemplateMIMEType("application/json"),
+		),
+		Handler: h.readSLOResource,
+	}
+}
+
+func (h *mcpHandler) readSLOResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	// extract params
+	page, err := resp.PageFromArguments(request.Params.Arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	// call business logic
+	slos, err := h.repo.ListSLOs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing slos: %s", err)
 	}
+
+	slos, nextCursor := resp.Paginate(slos, page)
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: "application/json",
+			Text: resp.Success(ctx, List{
+				SLOs:       slos,
+				NextCursor: nextCursor,
+			}),
+		},
+	}, nil
 }
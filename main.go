@@ -13,9 +13,11 @@ import (
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/config"
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/core"
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog"
+	catalog_complexity "github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/complexity"
 	catalog_constants "github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/constants"
 	catalog_repo "github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/repo"
 	catalog_search "github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/search"
+	catalog_spec "github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/servicecatalog/spec"
 	"github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/slo"
 	slo_constants "github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/slo/constants"
 	slo_repo "github.com/MarcGrol/service-catalog-mcp-server/internal/plugin/slo/repo"
@@ -34,6 +36,10 @@ func run() error {
 
 	ctx := context.Background()
 
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		return runDiff(ctx, os.Args[2:])
+	}
+
 	// Override if embedded files exist
 	serviceCatalogDatabaseFilename, serviceCatalogDatabaseCleanup, err := data.UnpackServiceCatalogDatabase(ctx)
 	if err != nil {
@@ -54,7 +60,18 @@ func run() error {
 	mcpHandlers := []core.MCPService{}
 	if cfg.Mode == config.Both || cfg.Mode == config.ServiceCatalog {
 		// Initialize catalog repository
-		catalogRepo := catalog_repo.New(cfg.PluginConfigs[catalog_constants.CatalogDatabaseFilenameKey])
+		repoOptions := []catalog_repo.Option{}
+		complexityConfigFilename := cfg.PluginConfigs[catalog_constants.ComplexityConfigFilenameKey]
+		if complexityConfigFilename != "" {
+			complexityConfig, err := catalog_complexity.LoadConfig(complexityConfigFilename)
+			if err != nil {
+				log.Warn().Msgf("Error loading complexity config: %v", err)
+				return err
+			}
+			repoOptions = append(repoOptions, catalog_repo.WithComplexityConfig(complexityConfig))
+		}
+
+		catalogRepo := catalog_repo.New(cfg.PluginConfigs[catalog_constants.CatalogDatabaseFilenameKey], repoOptions...)
 		err := catalogRepo.Open(ctx)
 		if err != nil {
 			log.Warn().Msgf("Error opening catalog-database: %v", err)
@@ -63,10 +80,61 @@ func run() error {
 		defer catalogRepo.Close(ctx)
 
 		// Initialize catalog search index
-		catalogSearchIndex := catalog_search.NewSearchIndex(ctx, catalogRepo)
+		searchOptions := []catalog_search.Option{}
+		searchWeights := cfg.PluginConfigs[catalog_constants.SearchWeightsKey]
+		if searchWeights != "" {
+			weights, err := catalog_search.ParseWeights(searchWeights)
+			if err != nil {
+				log.Warn().Msgf("Error parsing search weights: %v", err)
+				return err
+			}
+			searchOptions = append(searchOptions, catalog_search.WithWeights(weights))
+		}
+		catalogSearchIndex := catalog_search.NewSearchIndex(ctx, catalogRepo, searchOptions...)
+
+		options := []servicecatalog.Option{
+			servicecatalog.WithSpecLoader(catalog_spec.NewLoader(cfg.PluginConfigs[catalog_constants.SpecRootDirKey])),
+		}
+
+		// Initialize optional older catalog to compare with
+		baselineFilename := cfg.PluginConfigs[catalog_constants.BaselineCatalogDatabaseFilenameKey]
+		if baselineFilename != "" {
+			baselineRepo := catalog_repo.New(baselineFilename)
+			err := baselineRepo.Open(ctx)
+			if err != nil {
+				log.Warn().Msgf("Error opening baseline catalog-database: %v", err)
+				return err
+			}
+			defer baselineRepo.Close(ctx)
+
+			options = append(options, servicecatalog.WithBaselineCatalog(baselineRepo))
+
+			baselineSpecRootDir := cfg.PluginConfigs[catalog_constants.BaselineSpecRootDirKey]
+			if baselineSpecRootDir != "" {
+				options = append(options, servicecatalog.WithBaselineSpecLoader(catalog_spec.NewLoader(baselineSpecRootDir)))
+			}
+		}
+
+		// Initialize optional file to keep the semantic model in, the catalog database itself is never written
+		semanticModelFilename := cfg.PluginConfigs[catalog_constants.SemanticModelFilenameKey]
+		if semanticModelFilename != "" {
+			semanticModelStore := catalog_repo.NewSemanticModelStore(semanticModelFilename)
+			err := semanticModelStore.Open(ctx)
+			if err != nil {
+				log.Warn().Msgf("Error opening semantic model database: %v", err)
+				return err
+			}
+			defer semanticModelStore.Close(ctx)
+
+			options = append(options, servicecatalog.WithSemanticModelStore(semanticModelStore))
+		}
+
+		if cfg.PluginConfigs[catalog_constants.EnableQueryCatalogKey] == "true" {
+			options = append(options, servicecatalog.WithQueryCatalog())
+		}
 
 		// Initialize MCP handler
-		mcpHandlers = append(mcpHandlers, servicecatalog.NewMCPHandler(catalogRepo, catalogSearchIndex))
+		mcpHandlers = append(mcpHandlers, servicecatalog.NewMCPHandler(catalogRepo, catalogSearchIndex, options...))
 	}
 
 	if cfg.Mode == config.Both || cfg.Mode == config.SLO {